@@ -26,14 +26,17 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/ClusterCockpit/cc-backend/internal/api"
+	"github.com/ClusterCockpit/cc-backend/internal/archiver"
 	"github.com/ClusterCockpit/cc-backend/internal/auth"
 	"github.com/ClusterCockpit/cc-backend/internal/config"
 	"github.com/ClusterCockpit/cc-backend/internal/graph"
 	"github.com/ClusterCockpit/cc-backend/internal/graph/generated"
 	"github.com/ClusterCockpit/cc-backend/internal/metricdata"
+	"github.com/ClusterCockpit/cc-backend/internal/metricstore"
 	"github.com/ClusterCockpit/cc-backend/internal/repository"
 	"github.com/ClusterCockpit/cc-backend/internal/routerConfig"
 	"github.com/ClusterCockpit/cc-backend/internal/runtimeEnv"
+	"github.com/ClusterCockpit/cc-backend/internal/tagger"
 	"github.com/ClusterCockpit/cc-backend/pkg/archive"
 	"github.com/ClusterCockpit/cc-backend/pkg/log"
 	"github.com/ClusterCockpit/cc-backend/web"
@@ -62,19 +65,24 @@ var (
 )
 
 func main() {
-	var flagReinitDB, flagServer, flagSyncLDAP, flagGops, flagDev, flagVersion bool
-	var flagNewUser, flagDelUser, flagGenJWT, flagConfigFile, flagImportJob string
+	var flagReinitDB, flagServer, flagSyncLDAP, flagGops, flagDev, flagVersion, flagApplyJobRules, flagDryRun bool
+	var flagNewUser, flagDelUser, flagGenJWT, flagConfigFile, flagImportJob, flagBackupDB, flagRestoreDB, flagRearchive string
 	flag.BoolVar(&flagReinitDB, "init-db", false, "Go through job-archive and re-initialize the 'job', 'tag', and 'jobtag' tables (all running jobs will be lost!)")
 	flag.BoolVar(&flagSyncLDAP, "sync-ldap", false, "Sync the 'user' table with ldap")
 	flag.BoolVar(&flagServer, "server", false, "Start a server, continues listening on port after initialization and argument handling")
 	flag.BoolVar(&flagGops, "gops", false, "Listen via github.com/google/gops/agent (for debugging)")
 	flag.BoolVar(&flagDev, "dev", false, "Enable development components: GraphQL Playground and Swagger UI")
 	flag.BoolVar(&flagVersion, "version", false, "Show version information and exit")
+	flag.BoolVar(&flagApplyJobRules, "apply-job-rules", false, "Apply the job rules configured in config.json to all archived jobs")
 	flag.StringVar(&flagConfigFile, "config", "./config.json", "Specify alternative path to `config.json`")
 	flag.StringVar(&flagNewUser, "add-user", "", "Add a new user. Argument format: `<username>:[admin,support,api,user]:<password>`")
 	flag.StringVar(&flagDelUser, "del-user", "", "Remove user by `username`")
 	flag.StringVar(&flagGenJWT, "jwt", "", "Generate and print a JWT for the user specified by its `username`")
 	flag.StringVar(&flagImportJob, "import-job", "", "Import a job. Argument format: `<path-to-meta.json>:<path-to-data.json>,...`")
+	flag.StringVar(&flagBackupDB, "backup-db", "", "Write a consistent backup of the database to `file` (can be used while a server is running)")
+	flag.StringVar(&flagRearchive, "rearchive", "", "Load the metric data of the archived jobs matching the `filter` (JSON, e.g. '{\"cluster\": {\"eq\": \"fritz\"}}') again and recompute their statistics")
+	flag.BoolVar(&flagDryRun, "dry-run", false, "Only show what --rearchive would do")
+	flag.StringVar(&flagRestoreDB, "restore-db", "", "Replace all data in the database with the backup in `file` (created by --backup-db)")
 	flag.Parse()
 
 	if flagVersion {
@@ -179,22 +187,60 @@ func main() {
 		log.Fatal(err)
 	}
 
+	if config.Keys.MetricStore != nil {
+		if err := metricstore.Init(config.Keys.MetricStore); err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	if err := metricdata.Init(config.Keys.DisableArchive); err != nil {
 		log.Fatal(err)
 	}
 
+	if err := tagger.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	if flagBackupDB != "" {
+		if err := repository.BackupDB(flagBackupDB); err != nil {
+			log.Fatalf("backup failed: %s", err.Error())
+		}
+		log.Infof("database backup written to %#v", flagBackupDB)
+	}
+
 	if flagReinitDB {
 		if err := repository.InitDB(); err != nil {
 			log.Fatal(err)
 		}
 	}
 
+	if flagRestoreDB != "" {
+		if err := repository.RestoreDB(flagRestoreDB); err != nil {
+			log.Fatalf("restore failed: %s", err.Error())
+		}
+		log.Infof("database restored from %#v", flagRestoreDB)
+	}
+
 	if flagImportJob != "" {
 		if err := repository.HandleImportFlag(flagImportJob); err != nil {
 			log.Fatalf("import failed: %s", err.Error())
 		}
 	}
 
+	if flagRearchive != "" {
+		if err := archiver.HandleRearchiveFlag(flagRearchive, flagDryRun); err != nil {
+			log.Fatalf("re-archiving failed: %s", err.Error())
+		}
+	}
+
+	if flagApplyJobRules {
+		n, err := tagger.ApplyRules(repository.GetJobRepository())
+		if err != nil {
+			log.Fatalf("applying the job rules failed: %s", err.Error())
+		}
+		log.Infof("job rules applied, %d jobs matched", n)
+	}
+
 	if !flagServer {
 		return
 	}
@@ -415,10 +461,16 @@ func main() {
 		// First shut down the server gracefully (waiting for all ongoing requests)
 		server.Shutdown(context.Background())
 
-		// Then, wait for any async archivings still pending...
-		api.OngoingArchivings.Wait()
+		// Then, wait for the ongoing archiving attempts (pending ones are resumed after a restart)...
+		archiver.Shutdown()
+
+		// ...and save the data of the embedded metric store.
+		metricstore.Shutdown()
 	}()
 
+	// Archive the jobs still queued from a previous run (and all jobs stopped from now on).
+	archiver.Start()
+
 	if config.Keys.StopJobsExceedingWalltime > 0 {
 		go func() {
 			for range time.Tick(30 * time.Minute) {
@@ -24,6 +24,283 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/archiving/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Lists the stopped jobs that are not archived yet, oldest first. Jobs are removed from\nthe queue once they are archived successfully. Requires the admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "List the archiving queue",
+                "parameters": [
+                    {
+                        "enum": [
+                            "pending",
+                            "running",
+                            "failed",
+                            "abandoned"
+                        ],
+                        "type": "string",
+                        "description": "Only entries in this state",
+                        "name": "state",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Queue entries",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/schema.ArchivingTask"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/archiving/abandon/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Gives up a queue entry that is not running, the job is marked as failed to archive.\nThe entry stays in the queue and can be retried later. Requires the admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Abandon archiving a job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the queue entry",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated queue entry",
+                        "schema": {
+                            "$ref": "#/definitions/schema.ArchivingTask"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/archiving/retry/{id}": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Resets a queue entry that is not running, archiving the job is attempted again\nimmediately with a fresh number of attempts. Requires the admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Retry archiving a job",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID of the queue entry",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated queue entry",
+                        "schema": {
+                            "$ref": "#/definitions/schema.ArchivingTask"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/cache/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns the number of cache hits and misses since the start for the metric data of jobs\n(jobData), the statistics of running jobs (stats) and the data of nodes (nodeData).\nRequires the admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Metric data cache statistics",
+                "responses": {
+                    "200": {
+                        "description": "Cache statistics",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.CacheStatisticsApiResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/db/backup/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Creates a consistent backup of the database while cc-backend keeps running.\nFor SQLite, the result is a SQLite database file, for MySQL a portable JSON export.\nBoth can be restored with ` + "`" + `cc-backend --restore-db \u003cfile\u003e` + "`" + `. Requires the admin role.",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Download a backup of the database",
+                "responses": {
+                    "200": {
+                        "description": "Database backup",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/jobs/": {
             "get": {
                 "security": [
@@ -31,7 +308,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get a list of all jobs. Filters can be applied using query parameters.\nNumber of results can be limited by page. Results are sorted by descending startTime.",
+                "description": "Get a list of all jobs. Filters can be applied using query parameters.\nNumber of results can be limited by page. Results are sorted by descending startTime.\nIf ` + "`" + `after` + "`" + ` or ` + "`" + `before` + "`" + ` is passed, cursor based pagination is used instead: Pass the returned\n` + "`" + `endCursor` + "`" + ` as ` + "`" + `after` + "`" + ` to fetch the next batch of jobs (an empty ` + "`" + `after` + "`" + ` starts at the first job).\nThis is much faster for large job tables.",
                 "produces": [
                     "application/json"
                 ],
@@ -68,7 +345,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "Items per page (Default: 25)",
+                        "description": "Items per page (Default: 25, -1 for all)",
                         "name": "items-per-page",
                         "in": "query"
                     },
@@ -78,6 +355,18 @@ const docTemplate = `{
                         "name": "page",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "Cursor of the job after which the results start",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor of the job before which the results end",
+                        "name": "before",
+                        "in": "query"
+                    },
                     {
                         "type": "boolean",
                         "description": "Include metadata (e.g. jobScript) in response",
@@ -87,12 +376,9 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Array of matching jobs",
+                        "description": "Job array and cursors",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/schema.Job"
-                            }
+                            "$ref": "#/definitions/api.GetJobsApiResponse"
                         }
                     },
                     "400": {
@@ -331,6 +617,110 @@ const docTemplate = `{
                 }
             }
         },
+        "/jobs/rearchive/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns the progress of the current or last run started via POST /jobs/rearchive/,\nincluding the results of the latest jobs. Requires the admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Progress of archiving jobs again",
+                "responses": {
+                    "200": {
+                        "description": "Progress",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RearchiveRun"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Resource not found: no run was started",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Starts loading the metric data of the archived jobs matching the filter from the metric data\nrepositories again, in the background. The statistics are recomputed, the job-archive is\noverwritten and the database is updated. Jobs whose data is not (completely) available anymore are skipped.\nOnly one run can be in progress at a time. Requires the admin role.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Archive jobs again",
+                "parameters": [
+                    {
+                        "description": "Job filter and dry run option",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.RearchiveApiRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Run started",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RearchiveRun"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict: a run is in progress already",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/jobs/start_job/": {
             "post": {
                 "security": [
@@ -581,23 +971,210 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Array of tag-objects to add",
+                        "description": "Array of tag-objects to add",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.ApiTag"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated job resource",
+                        "schema": {
+                            "$ref": "#/definitions/schema.Job"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Job or tag does not exist",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/metricdata/health/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns the health of the metric data repositories of every cluster that uses a failover chain\nand how many requests each repository answered. Requires the admin role.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Health of failover chains of metric data repositories",
+                "responses": {
+                    "200": {
+                        "description": "Health by cluster",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.MetricDataHealthApiResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nodestate/": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Report the current state of a list of nodes, e.g. as seen by the batch scheduler.\nNodes unknown to cc-backend are added to the node inventory.\nA state history entry is only recorded if the state or reason of a node changed.\nReports older than the current state of a node are only added to the history.\nAll nodes are updated together, if one update fails none is applied.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "nodestate"
+                ],
+                "summary": "Update the state of cluster nodes",
+                "parameters": [
+                    {
+                        "description": "Request body containing the cluster and node states",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.UpdateNodeStatesApiRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated nodes",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/schema.Node"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity: updating node state failed",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/write/": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Stores metric data in InfluxDB line protocol (as sent by the cc-metric-collector) in the\nembedded metric store. The measurement is the metric, the value has to be in the field ` + "`" + `value` + "`" + `.\nThe tags ` + "`" + `hostname` + "`" + `, ` + "`" + `type` + "`" + `, ` + "`" + `type-id` + "`" + ` and ` + "`" + `cluster` + "`" + ` are used. Only available if the\n` + "`" + `metric-store` + "`" + ` option is set. Requires the api role.",
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "metrics"
+                ],
+                "summary": "Write metric data",
+                "parameters": [
+                    {
+                        "description": "Metric data in InfluxDB line protocol",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/api.ApiTag"
-                            }
+                            "type": "string"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cluster of lines without a cluster tag",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unit of the timestamps: s (default), ms, us or ns",
+                        "name": "precision",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated job resource",
+                        "description": "Number of values stored and dropped",
                         "schema": {
-                            "$ref": "#/definitions/schema.Job"
+                            "$ref": "#/definitions/api.WriteMetricsApiResponse"
                         }
                     },
                     "400": {
@@ -612,14 +1189,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Job or tag does not exist",
-                        "schema": {
-                            "$ref": "#/definitions/api.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/api.ErrorResponse"
                         }
@@ -629,6 +1200,80 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "api.ApiBackendHealth": {
+            "type": "object",
+            "properties": {
+                "answered": {
+                    "description": "Number of requests answered by this repository",
+                    "type": "integer",
+                    "example": 1250
+                },
+                "failures": {
+                    "description": "Number of consecutive failures",
+                    "type": "integer",
+                    "example": 0
+                },
+                "healthy": {
+                    "description": "False while the repository is skipped after failures",
+                    "type": "boolean",
+                    "example": true
+                },
+                "lastAnswered": {
+                    "description": "Time of the last answered request as epoch",
+                    "type": "integer",
+                    "example": 1649723812
+                },
+                "lastError": {
+                    "description": "Error of the last failure",
+                    "type": "string"
+                },
+                "lastFailure": {
+                    "description": "Time of the last failure as epoch",
+                    "type": "integer"
+                },
+                "lastSuccess": {
+                    "description": "Time of the last successful request as epoch",
+                    "type": "integer",
+                    "example": 1649723812
+                },
+                "name": {
+                    "description": "Name of the repository (kind and position if not configured)",
+                    "type": "string",
+                    "example": "cc-metric-store[0]"
+                }
+            }
+        },
+        "api.ApiNodeState": {
+            "type": "object",
+            "required": [
+                "hostname",
+                "state"
+            ],
+            "properties": {
+                "hostname": {
+                    "description": "Hostname of the node",
+                    "type": "string",
+                    "example": "f0101"
+                },
+                "reason": {
+                    "description": "Reason for the state",
+                    "type": "string",
+                    "example": "Not responding"
+                },
+                "state": {
+                    "description": "Current state of the node",
+                    "type": "string",
+                    "enum": [
+                        "idle",
+                        "allocated",
+                        "down",
+                        "drain",
+                        "maintenance"
+                    ],
+                    "example": "idle"
+                }
+            }
+        },
         "api.ApiTag": {
             "type": "object",
             "properties": {
@@ -644,6 +1289,26 @@ const docTemplate = `{
                 }
             }
         },
+        "api.CacheStatisticsApiResponse": {
+            "type": "object",
+            "properties": {
+                "hits": {
+                    "description": "Requests answered from the cache since the start",
+                    "type": "integer",
+                    "example": 1250
+                },
+                "kind": {
+                    "description": "Kind of cached data (jobData, stats or nodeData)",
+                    "type": "string",
+                    "example": "nodeData"
+                },
+                "misses": {
+                    "description": "Requests that loaded the data since the start",
+                    "type": "integer",
+                    "example": 80
+                }
+            }
+        },
         "api.DeleteJobApiRequest": {
             "type": "object",
             "required": [
@@ -688,6 +1353,60 @@ const docTemplate = `{
                 }
             }
         },
+        "api.GetJobsApiResponse": {
+            "type": "object",
+            "properties": {
+                "endCursor": {
+                    "description": "Cursor of the last job (only set with after or before)",
+                    "type": "string"
+                },
+                "hasMore": {
+                    "description": "More jobs follow in the direction of the pagination",
+                    "type": "boolean"
+                },
+                "jobs": {
+                    "description": "Array of jobs",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.JobMeta"
+                    }
+                },
+                "startCursor": {
+                    "description": "Cursor of the first job (only set with after or before)",
+                    "type": "string"
+                }
+            }
+        },
+        "api.MetricDataHealthApiResponse": {
+            "type": "object",
+            "properties": {
+                "cluster": {
+                    "description": "Cluster with a failover chain of metric data repositories",
+                    "type": "string",
+                    "example": "fritz"
+                },
+                "repositories": {
+                    "description": "Repositories in the order of the chain",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ApiBackendHealth"
+                    }
+                }
+            }
+        },
+        "api.RearchiveApiRequest": {
+            "type": "object",
+            "properties": {
+                "dryRun": {
+                    "description": "Only recompute the statistics, do not change the archive or database",
+                    "type": "boolean"
+                },
+                "filter": {
+                    "description": "Job filter as in the GraphQL API, e.g. {\"cluster\": {\"eq\": \"fritz\"}}",
+                    "type": "object"
+                }
+            }
+        },
         "api.StartJobApiResponse": {
             "type": "object",
             "properties": {
@@ -738,6 +1457,105 @@ const docTemplate = `{
                 }
             }
         },
+        "api.UpdateNodeStatesApiRequest": {
+            "type": "object",
+            "required": [
+                "cluster",
+                "nodes"
+            ],
+            "properties": {
+                "cluster": {
+                    "description": "Cluster of the nodes",
+                    "type": "string",
+                    "example": "fritz"
+                },
+                "nodes": {
+                    "description": "Current state of each reported node",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.ApiNodeState"
+                    }
+                },
+                "timeStamp": {
+                    "description": "Time of the state report as epoch (Default: now)",
+                    "type": "integer",
+                    "example": 1649723812
+                }
+            }
+        },
+        "api.WriteMetricsApiResponse": {
+            "type": "object",
+            "properties": {
+                "dropped": {
+                    "description": "Number of values of unknown metrics, in the wrong scope or too old",
+                    "type": "integer"
+                },
+                "stored": {
+                    "description": "Number of values stored",
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.ArchivedMetric": {
+            "description": "Which metric data was written to the job-archive according to the archive policy of the cluster.",
+            "type": "object",
+            "properties": {
+                "maxNodes": {
+                    "description": "Finer scopes than node were dropped for jobs with more nodes",
+                    "type": "integer",
+                    "example": 8
+                },
+                "scopes": {
+                    "description": "Archived scopes",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "timestep": {
+                    "description": "Timestep of the archived data in seconds",
+                    "type": "integer",
+                    "example": 60
+                }
+            }
+        },
+        "schema.ArchivingTask": {
+            "description": "An entry of the archiving queue. Entries are removed once the job was archived successfully.",
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "description": "Number of archiving attempts so far",
+                    "type": "integer",
+                    "example": 1
+                },
+                "createdAt": {
+                    "description": "Time the entry was created as 'time.Time' data type",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "The unique identifier of the entry in the database",
+                    "type": "integer"
+                },
+                "jobDbId": {
+                    "description": "The database id of the job to archive",
+                    "type": "integer",
+                    "example": 123000
+                },
+                "lastError": {
+                    "description": "Error of the last failed attempt",
+                    "type": "string"
+                },
+                "nextAttempt": {
+                    "description": "Time of the next attempt (if pending) as 'time.Time' data type",
+                    "type": "string"
+                },
+                "state": {
+                    "description": "State of the entry",
+                    "type": "string",
+                    "example": "pending"
+                }
+            }
+        },
         "schema.Job": {
             "description": "Information of a HPC job.",
             "type": "object",
@@ -874,6 +1692,13 @@ const docTemplate = `{
             "description": "Meta data information of a HPC job.",
             "type": "object",
             "properties": {
+                "archived": {
+                    "description": "Scopes and resolution of the archived metric data",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/schema.ArchivedMetric"
+                    }
+                },
                 "arrayJobId": {
                     "description": "The unique identifier of an array job",
                     "type": "integer",
@@ -1040,6 +1865,145 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.Node": {
+            "description": "Current state of a cluster node.",
+            "type": "object",
+            "properties": {
+                "cluster": {
+                    "description": "The unique identifier of a cluster",
+                    "type": "string",
+                    "example": "fritz"
+                },
+                "hostname": {
+                    "description": "Hostname of the node",
+                    "type": "string",
+                    "example": "f0101"
+                },
+                "id": {
+                    "description": "The unique identifier of a node in the database",
+                    "type": "integer"
+                },
+                "nodeState": {
+                    "description": "Current state of the node",
+                    "type": "string",
+                    "example": "idle"
+                },
+                "reason": {
+                    "description": "Reason for the current state as reported by the scheduler or health check",
+                    "type": "string",
+                    "example": "Not responding"
+                },
+                "subCluster": {
+                    "description": "The unique identifier of a sub cluster",
+                    "type": "string",
+                    "example": "main"
+                },
+                "timeStamp": {
+                    "description": "Time of the last state change as 'time.Time' data type",
+                    "type": "string"
+                }
+            }
+        },
+        "schema.RearchiveResult": {
+            "description": "Outcome of archiving one job again.",
+            "type": "object",
+            "properties": {
+                "cluster": {
+                    "description": "The unique identifier of a cluster",
+                    "type": "string",
+                    "example": "fritz"
+                },
+                "error": {
+                    "description": "Why the job was skipped or archiving it failed",
+                    "type": "string",
+                    "example": "no metric data"
+                },
+                "id": {
+                    "description": "The unique identifier of a job in the database",
+                    "type": "integer"
+                },
+                "jobId": {
+                    "description": "The unique identifier of a job",
+                    "type": "integer",
+                    "example": 123000
+                },
+                "skipped": {
+                    "description": "The job was not archived again (see error)",
+                    "type": "boolean"
+                },
+                "startTime": {
+                    "description": "Start epoch time stamp in seconds",
+                    "type": "integer",
+                    "example": 1649723812
+                },
+                "statistics": {
+                    "description": "Recomputed metric statistics of the job",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/schema.JobStatistics"
+                    }
+                }
+            }
+        },
+        "schema.RearchiveRun": {
+            "description": "Progress of archiving jobs again in the background.",
+            "type": "object",
+            "properties": {
+                "dryRun": {
+                    "description": "Only the statistics are computed",
+                    "type": "boolean"
+                },
+                "error": {
+                    "description": "Why the run was aborted",
+                    "type": "string"
+                },
+                "processed": {
+                    "description": "Number of jobs processed so far",
+                    "type": "integer"
+                },
+                "results": {
+                    "description": "The results of the latest jobs processed",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.RearchiveResult"
+                    }
+                },
+                "running": {
+                    "description": "The run is still in progress",
+                    "type": "boolean"
+                },
+                "startTime": {
+                    "description": "Time the run was started",
+                    "type": "string"
+                },
+                "summary": {
+                    "description": "Outcome so far",
+                    "$ref": "#/definitions/schema.RearchiveSummary"
+                }
+            }
+        },
+        "schema.RearchiveSummary": {
+            "description": "Number of jobs archived again.",
+            "type": "object",
+            "properties": {
+                "done": {
+                    "description": "Jobs archived again (or that would be in a dry run)",
+                    "type": "integer"
+                },
+                "failed": {
+                    "description": "Jobs where loading the data or updating the archive failed",
+                    "type": "integer"
+                },
+                "skipped": {
+                    "description": "Jobs that are not archived yet or whose data is not available anymore",
+                    "type": "integer"
+                },
+                "total": {
+                    "description": "Number of jobs matching the filters",
+                    "type": "integer"
+                }
+            }
+        },
         "schema.Resource": {
             "description": "A resource used by a job",
             "type": "object",
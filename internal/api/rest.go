@@ -6,7 +6,6 @@ package api
 
 import (
 	"bufio"
-	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
@@ -15,15 +14,18 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/ClusterCockpit/cc-backend/internal/archiver"
 	"github.com/ClusterCockpit/cc-backend/internal/auth"
 	"github.com/ClusterCockpit/cc-backend/internal/graph"
 	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
 	"github.com/ClusterCockpit/cc-backend/internal/metricdata"
+	"github.com/ClusterCockpit/cc-backend/internal/metricstore"
 	"github.com/ClusterCockpit/cc-backend/internal/repository"
 	"github.com/ClusterCockpit/cc-backend/pkg/archive"
 	"github.com/ClusterCockpit/cc-backend/pkg/log"
@@ -56,7 +58,7 @@ type RestApi struct {
 	Resolver          *graph.Resolver
 	Authentication    *auth.Authentication
 	MachineStateDir   string
-	OngoingArchivings sync.WaitGroup
+	OngoingArchivings sync.WaitGroup // Done once the first archiving attempt of a job stopped via this API finished
 	RepositoryMutex   sync.Mutex
 }
 
@@ -76,6 +78,19 @@ func (api *RestApi) MountRoutes(r *mux.Router) {
 	r.HandleFunc("/jobs/delete_job/", api.deleteJobByRequest).Methods(http.MethodDelete)
 	r.HandleFunc("/jobs/delete_job/{id}", api.deleteJobById).Methods(http.MethodDelete)
 	r.HandleFunc("/jobs/delete_job_before/{ts}", api.deleteJobBefore).Methods(http.MethodDelete)
+	r.HandleFunc("/jobs/rearchive/", api.startRearchive).Methods(http.MethodPost)
+	r.HandleFunc("/jobs/rearchive/", api.getRearchiveStatus).Methods(http.MethodGet)
+
+	r.HandleFunc("/nodestate/", api.updateNodeStates).Methods(http.MethodPost, http.MethodPut)
+
+	r.HandleFunc("/db/backup/", api.backupDB).Methods(http.MethodGet)
+
+	r.HandleFunc("/archiving/", api.getArchivingQueue).Methods(http.MethodGet)
+	r.HandleFunc("/archiving/retry/{id}", api.retryArchiving).Methods(http.MethodPost)
+	r.HandleFunc("/archiving/abandon/{id}", api.abandonArchiving).Methods(http.MethodPost)
+
+	r.HandleFunc("/cache/", api.getCacheStatistics).Methods(http.MethodGet)
+	r.HandleFunc("/metricdata/health/", api.getMetricDataHealth).Methods(http.MethodGet)
 
 	if api.Authentication != nil {
 		r.HandleFunc("/jwt/", api.getJWT).Methods(http.MethodGet)
@@ -86,6 +101,10 @@ func (api *RestApi) MountRoutes(r *mux.Router) {
 		r.HandleFunc("/configuration/", api.updateConfiguration).Methods(http.MethodPost)
 	}
 
+	if metricstore.Enabled() {
+		r.HandleFunc("/write/", api.writeMetrics).Methods(http.MethodPost)
+	}
+
 	if api.MachineStateDir != "" {
 		r.HandleFunc("/machine_state/{cluster}/{host}", api.getMachineState).Methods(http.MethodGet)
 		r.HandleFunc("/machine_state/{cluster}/{host}", api.putMachineState).Methods(http.MethodPut, http.MethodPost)
@@ -120,6 +139,51 @@ type DeleteJobApiRequest struct {
 	StartTime *int64  `json:"startTime" example:"1649723812"`             // Start Time of job as epoch
 }
 
+// GetJobsApiResponse model
+type GetJobsApiResponse struct {
+	Jobs        []*schema.JobMeta `json:"jobs"`                  // Array of jobs
+	StartCursor string            `json:"startCursor,omitempty"` // Cursor of the first job (only set with after or before)
+	EndCursor   string            `json:"endCursor,omitempty"`   // Cursor of the last job (only set with after or before)
+	HasMore     bool              `json:"hasMore,omitempty"`     // More jobs follow in the direction of the pagination
+}
+
+// RearchiveApiRequest model
+type RearchiveApiRequest struct {
+	Filter *model.JobFilter `json:"filter" swaggertype:"object"` // Job filter as in the GraphQL API, e.g. {"cluster": {"eq": "fritz"}}
+	DryRun bool             `json:"dryRun"`                      // Only recompute the statistics, do not change the archive or database
+}
+
+// WriteMetricsApiResponse model
+type WriteMetricsApiResponse struct {
+	Stored  int `json:"stored"`  // Number of values stored
+	Dropped int `json:"dropped"` // Number of values of unknown metrics, in the wrong scope or too old
+}
+
+// CacheStatisticsApiResponse model
+type CacheStatisticsApiResponse struct {
+	Kind   string `json:"kind" example:"nodeData"` // Kind of cached data (jobData, stats or nodeData)
+	Hits   int64  `json:"hits" example:"1250"`     // Requests answered from the cache since the start
+	Misses int64  `json:"misses" example:"80"`     // Requests that loaded the data since the start
+}
+
+// MetricDataHealthApiResponse model
+type MetricDataHealthApiResponse struct {
+	Cluster      string             `json:"cluster" example:"fritz"` // Cluster with a failover chain of metric data repositories
+	Repositories []ApiBackendHealth `json:"repositories"`            // Repositories in the order of the chain
+}
+
+// ApiBackendHealth model
+type ApiBackendHealth struct {
+	Name         string `json:"name" example:"cc-metric-store[0]"`           // Name of the repository (kind and position if not configured)
+	Healthy      bool   `json:"healthy" example:"true"`                      // False while the repository is skipped after failures
+	Failures     int    `json:"failures" example:"0"`                        // Number of consecutive failures
+	LastError    string `json:"lastError,omitempty"`                         // Error of the last failure
+	LastFailure  int64  `json:"lastFailure,omitempty"`                       // Time of the last failure as epoch
+	LastSuccess  int64  `json:"lastSuccess,omitempty" example:"1649723812"`  // Time of the last successful request as epoch
+	Answered     int    `json:"answered" example:"1250"`                     // Number of requests answered by this repository
+	LastAnswered int64  `json:"lastAnswered,omitempty" example:"1649723812"` // Time of the last answered request as epoch
+}
+
 // ErrorResponse model
 type ErrorResponse struct {
 	// Statustext of Errorcode
@@ -136,6 +200,20 @@ type ApiTag struct {
 
 type TagJobApiRequest []*ApiTag
 
+// UpdateNodeStatesApiRequest model
+type UpdateNodeStatesApiRequest struct {
+	Cluster   string          `json:"cluster" validate:"required" example:"fritz"` // Cluster of the nodes
+	TimeStamp *int64          `json:"timeStamp" example:"1649723812"`              // Time of the state report as epoch (Default: now)
+	Nodes     []*ApiNodeState `json:"nodes" validate:"required"`                   // Current state of each reported node
+}
+
+// ApiNodeState model
+type ApiNodeState struct {
+	Hostname string           `json:"hostname" validate:"required" example:"f0101"`                                           // Hostname of the node
+	State    schema.NodeState `json:"state" validate:"required" example:"idle" enums:"idle,allocated,down,drain,maintenance"` // Current state of the node
+	Reason   string           `json:"reason" example:"Not responding"`                                                        // Reason for the state
+}
+
 func handleError(err error, statusCode int, rw http.ResponseWriter) {
 	log.Warnf("REST API: %s", err.Error())
 	rw.Header().Add("Content-Type", "application/json")
@@ -157,14 +235,19 @@ func decode(r io.Reader, val interface{}) error {
 // @tags query
 // @description Get a list of all jobs. Filters can be applied using query parameters.
 // @description Number of results can be limited by page. Results are sorted by descending startTime.
+// @description If `after` or `before` is passed, cursor based pagination is used instead: Pass the returned
+// @description `endCursor` as `after` to fetch the next batch of jobs (an empty `after` starts at the first job).
+// @description This is much faster for large job tables.
 // @produce     json
 // @param       state          query    string            false "Job State" Enums(running, completed, failed, cancelled, stopped, timeout)
 // @param       cluster        query    string            false "Job Cluster"
 // @param       start-time     query    string            false "Syntax: '$from-$to', as unix epoch timestamps in seconds"
-// @param       items-per-page query    int               false "Items per page (Default: 25)"
+// @param       items-per-page query    int               false "Items per page (Default: 25, -1 for all)"
 // @param       page           query    int               false "Page Number (Default: 1)"
+// @param       after          query    string            false "Cursor of the job after which the results start"
+// @param       before         query    string            false "Cursor of the job before which the results end"
 // @param       with-metadata  query    bool              false "Include metadata (e.g. jobScript) in response"
-// @success     200            {array}  schema.Job              "Array of matching jobs"
+// @success     200            {object} api.GetJobsApiResponse  "Job array and cursors"
 // @failure     400            {object} api.ErrorResponse       "Bad Request"
 // @failure     401   		   {object} api.ErrorResponse       "Unauthorized"
 // @failure     500            {object} api.ErrorResponse       "Internal Server Error"
@@ -176,10 +259,11 @@ func (api *RestApi) getJobs(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	withMetadata := false
+	withMetadata, usePages := false, false
+	var after, before *string
 	filter := &model.JobFilter{}
 	page := &model.PageRequest{ItemsPerPage: 25, Page: 1}
-	order := &model.OrderByInput{Field: "startTime", Order: model.SortDirectionEnumDesc}
+	order := []*model.OrderByInput{{Field: model.SortByAttributeStartTime, Order: model.SortDirectionEnumDesc}}
 
 	for key, vals := range r.URL.Query() {
 		switch key {
@@ -218,7 +302,11 @@ func (api *RestApi) getJobs(rw http.ResponseWriter, r *http.Request) {
 				http.Error(rw, err.Error(), http.StatusBadRequest)
 				return
 			}
-			page.Page = x
+			page.Page, usePages = x, true
+		case "after":
+			after = &vals[0]
+		case "before":
+			before = &vals[0]
 		case "items-per-page":
 			x, err := strconv.Atoi(vals[0])
 			if err != nil {
@@ -234,12 +322,37 @@ func (api *RestApi) getJobs(rw http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	jobs, err := api.JobRepository.QueryJobs(r.Context(), []*model.JobFilter{filter}, page, order)
-	if err != nil {
-		http.Error(rw, err.Error(), http.StatusInternalServerError)
+	if usePages && (after != nil || before != nil) {
+		http.Error(rw, "the query parameter page can not be combined with after or before", http.StatusBadRequest)
 		return
 	}
 
+	var jobs []*schema.Job
+	var err error
+	response := GetJobsApiResponse{}
+	if after == nil && before == nil {
+		jobs, err = api.JobRepository.QueryJobs(r.Context(), []*model.JobFilter{filter}, page, order)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	} else {
+		res, err := api.JobRepository.QueryJobsKeyset(r.Context(), []*model.JobFilter{filter}, page.ItemsPerPage, order, after, before)
+		if errors.Is(err, repository.ErrInvalidCursor) {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		} else if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		jobs = res.Jobs
+		response.StartCursor, response.EndCursor, response.HasMore = res.StartCursor, res.EndCursor, res.HasNextPage
+		if before != nil {
+			response.HasMore = res.HasPreviousPage
+		}
+	}
+
 	results := make([]*schema.JobMeta, 0, len(jobs))
 	for _, job := range jobs {
 		if withMetadata {
@@ -277,9 +390,8 @@ func (api *RestApi) getJobs(rw http.ResponseWriter, r *http.Request) {
 	log.Debugf("/api/jobs: %d jobs returned", len(results))
 	bw := bufio.NewWriter(rw)
 	defer bw.Flush()
-	if err := json.NewEncoder(bw).Encode(map[string]interface{}{
-		"jobs": results,
-	}); err != nil {
+	response.Jobs = results
+	if err := json.NewEncoder(bw).Encode(response); err != nil {
 		http.Error(rw, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -684,6 +796,71 @@ func (api *RestApi) deleteJobBefore(rw http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// updateNodeStates godoc
+// @summary     Update the state of cluster nodes
+// @tags nodestate
+// @description Report the current state of a list of nodes, e.g. as seen by the batch scheduler.
+// @description Nodes unknown to cc-backend are added to the node inventory.
+// @description A state history entry is only recorded if the state or reason of a node changed.
+// @description Reports older than the current state of a node are only added to the history.
+// @description All nodes are updated together, if one update fails none is applied.
+// @accept      json
+// @produce     json
+// @param       request body     api.UpdateNodeStatesApiRequest true "Request body containing the cluster and node states"
+// @success     200     {array}  schema.Node                    "Updated nodes"
+// @failure     400     {object} api.ErrorResponse              "Bad Request"
+// @failure     401     {object} api.ErrorResponse              "Unauthorized"
+// @failure     403     {object} api.ErrorResponse              "Forbidden"
+// @failure     422     {object} api.ErrorResponse              "Unprocessable Entity: updating node state failed"
+// @failure     500     {object} api.ErrorResponse              "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /nodestate/ [post]
+func (api *RestApi) updateNodeStates(rw http.ResponseWriter, r *http.Request) {
+	if user := auth.GetUser(r.Context()); user != nil && !user.HasRole(auth.RoleApi) {
+		handleError(fmt.Errorf("missing role: %#v", auth.RoleApi), http.StatusForbidden, rw)
+		return
+	}
+
+	req := UpdateNodeStatesApiRequest{}
+	if err := decode(r.Body, &req); err != nil {
+		handleError(fmt.Errorf("parsing request body failed: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+
+	if req.Cluster == "" {
+		handleError(errors.New("the field 'cluster' is required"), http.StatusBadRequest, rw)
+		return
+	}
+
+	ts := time.Now()
+	if req.TimeStamp != nil {
+		ts = time.Unix(*req.TimeStamp, 0)
+	}
+
+	updates := make([]repository.NodeStateUpdate, 0, len(req.Nodes))
+	for _, n := range req.Nodes {
+		if n == nil || n.Hostname == "" {
+			handleError(errors.New("the field 'hostname' is required for every node"), http.StatusBadRequest, rw)
+			return
+		}
+		if !n.State.Valid() {
+			handleError(fmt.Errorf("invalid state for node %#v: %#v", n.Hostname, n.State), http.StatusBadRequest, rw)
+			return
+		}
+		updates = append(updates, repository.NodeStateUpdate{Hostname: n.Hostname, State: n.State, Reason: n.Reason})
+	}
+
+	nodes, err := repository.GetNodeRepository().UpdateNodeStates(req.Cluster, updates, ts)
+	if err != nil {
+		handleError(fmt.Errorf("updating node state failed: %w", err), http.StatusUnprocessableEntity, rw)
+		return
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusOK)
+	json.NewEncoder(rw).Encode(nodes)
+}
+
 func (api *RestApi) checkAndHandleStopJob(rw http.ResponseWriter, job *schema.Job, req StopJobApiRequest) {
 
 	// Sanity checks
@@ -721,34 +898,13 @@ func (api *RestApi) checkAndHandleStopJob(rw http.ResponseWriter, job *schema.Jo
 		return
 	}
 
-	// We need to start a new goroutine as this functions needs to return
-	// for the response to be flushed to the client.
-	api.OngoingArchivings.Add(1) // So that a shutdown does not interrupt this goroutine.
-	go func() {
-		defer api.OngoingArchivings.Done()
-
-		if _, err := api.JobRepository.FetchMetadata(job); err != nil {
-			log.Errorf("archiving job (dbid: %d) failed: %s", job.ID, err.Error())
-			api.JobRepository.UpdateMonitoringStatus(job.ID, schema.MonitoringStatusArchivingFailed)
-			return
-		}
-
-		// metricdata.ArchiveJob will fetch all the data from a MetricDataRepository and create meta.json/data.json files
-		jobMeta, err := metricdata.ArchiveJob(job, context.Background())
-		if err != nil {
-			log.Errorf("archiving job (dbid: %d) failed: %s", job.ID, err.Error())
-			api.JobRepository.UpdateMonitoringStatus(job.ID, schema.MonitoringStatusArchivingFailed)
-			return
-		}
-
-		// Update the jobs database entry one last time:
-		if err := api.JobRepository.Archive(job.ID, schema.MonitoringStatusArchivingSuccessful, jobMeta.Statistics); err != nil {
-			log.Errorf("archiving job (dbid: %d) failed: %s", job.ID, err.Error())
-			return
-		}
-
-		log.Printf("archiving job (dbid: %d) successful", job.ID)
-	}()
+	// The job is archived asynchronously by the archiving workers, failed attempts are retried.
+	api.OngoingArchivings.Add(1)
+	if err := archiver.Enqueue(job, api.OngoingArchivings.Done); err != nil {
+		api.OngoingArchivings.Done()
+		log.Errorf("archiving job (dbid: %d) failed: %s", job.ID, err.Error())
+		api.JobRepository.UpdateMonitoringStatus(job.ID, schema.MonitoringStatusArchivingFailed)
+	}
 }
 
 // func (api *RestApi) importJob(rw http.ResponseWriter, r *http.Request) {
@@ -787,6 +943,16 @@ func (api *RestApi) getJobMetrics(rw http.ResponseWriter, r *http.Request) {
 		scopes = append(scopes, s)
 	}
 
+	var resolution *int
+	if r.URL.Query().Has("resolution") {
+		res, err := strconv.Atoi(r.URL.Query().Get("resolution"))
+		if err != nil || res < 0 {
+			http.Error(rw, "invalid resolution", http.StatusBadRequest)
+			return
+		}
+		resolution = &res
+	}
+
 	rw.Header().Add("Content-Type", "application/json")
 	rw.WriteHeader(http.StatusOK)
 
@@ -799,7 +965,7 @@ func (api *RestApi) getJobMetrics(rw http.ResponseWriter, r *http.Request) {
 		} `json:"error"`
 	}
 
-	data, err := api.Resolver.Query().JobMetrics(r.Context(), id, metrics, scopes)
+	data, err := api.Resolver.Query().JobMetrics(r.Context(), id, metrics, scopes, resolution)
 	if err != nil {
 		json.NewEncoder(rw).Encode(Respone{
 			Error: &struct {
@@ -816,6 +982,323 @@ func (api *RestApi) getJobMetrics(rw http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// backupDB godoc
+// @summary     Download a backup of the database
+// @tags admin
+// @description Creates a consistent backup of the database while cc-backend keeps running.
+// @description For SQLite, the result is a SQLite database file, for MySQL a portable JSON export.
+// @description Both can be restored with `cc-backend --restore-db <file>`. Requires the admin role.
+// @produce     application/octet-stream
+// @success     200     {file}   binary                 "Database backup"
+// @failure     401     {object} api.ErrorResponse      "Unauthorized"
+// @failure     403     {object} api.ErrorResponse      "Forbidden"
+// @failure     500     {object} api.ErrorResponse      "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /db/backup/ [get]
+func (api *RestApi) backupDB(rw http.ResponseWriter, r *http.Request) {
+	if user := auth.GetUser(r.Context()); user != nil && !user.HasRole(auth.RoleAdmin) {
+		handleError(fmt.Errorf("missing role: %#v", auth.RoleAdmin), http.StatusForbidden, rw)
+		return
+	}
+
+	dir, err := os.MkdirTemp("", "cc-backend-backup-")
+	if err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	filename := fmt.Sprintf("cc-backend-%s.backup", time.Now().Format("2006-01-02T15-04-05"))
+	file := filepath.Join(dir, filename)
+	if err := repository.BackupDB(file); err != nil {
+		handleError(fmt.Errorf("creating backup failed: %w", err), http.StatusInternalServerError, rw)
+		return
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+	defer f.Close()
+
+	rw.Header().Add("Content-Type", "application/octet-stream")
+	rw.Header().Add("Content-Disposition", fmt.Sprintf("attachment; filename=%#v", filename))
+	rw.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(rw, f); err != nil {
+		log.Warnf("REST API: sending backup failed: %s", err.Error())
+	}
+}
+
+// startRearchive godoc
+// @summary     Archive jobs again
+// @tags admin
+// @description Starts loading the metric data of the archived jobs matching the filter from the metric data
+// @description repositories again, in the background. The statistics are recomputed, the job-archive is
+// @description overwritten and the database is updated. Jobs whose data is not (completely) available anymore are skipped.
+// @description Only one run can be in progress at a time. Requires the admin role.
+// @accept      json
+// @produce     json
+// @param       request body     api.RearchiveApiRequest     true "Job filter and dry run option"
+// @success     202     {object} schema.RearchiveRun         "Run started"
+// @failure     400     {object} api.ErrorResponse           "Bad Request"
+// @failure     401     {object} api.ErrorResponse           "Unauthorized"
+// @failure     403     {object} api.ErrorResponse           "Forbidden"
+// @failure     409     {object} api.ErrorResponse           "Conflict: a run is in progress already"
+// @security    ApiKeyAuth
+// @router      /jobs/rearchive/ [post]
+func (api *RestApi) startRearchive(rw http.ResponseWriter, r *http.Request) {
+	if user := auth.GetUser(r.Context()); user != nil && !user.HasRole(auth.RoleAdmin) {
+		handleError(fmt.Errorf("missing role: %#v", auth.RoleAdmin), http.StatusForbidden, rw)
+		return
+	}
+
+	req := RearchiveApiRequest{}
+	if err := decode(r.Body, &req); err != nil {
+		handleError(fmt.Errorf("parsing request body failed: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+
+	filters := []*model.JobFilter{}
+	if req.Filter != nil {
+		filters = append(filters, req.Filter)
+	}
+
+	run, err := archiver.StartRearchive(filters, req.DryRun)
+	if err == archiver.ErrRearchiveRunning {
+		handleError(err, http.StatusConflict, rw)
+		return
+	} else if err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(rw).Encode(run)
+}
+
+// getRearchiveStatus godoc
+// @summary     Progress of archiving jobs again
+// @tags admin
+// @description Returns the progress of the current or last run started via POST /jobs/rearchive/,
+// @description including the results of the latest jobs. Requires the admin role.
+// @produce     json
+// @success     200     {object} schema.RearchiveRun         "Progress"
+// @failure     401     {object} api.ErrorResponse           "Unauthorized"
+// @failure     403     {object} api.ErrorResponse           "Forbidden"
+// @failure     404     {object} api.ErrorResponse           "Resource not found: no run was started"
+// @security    ApiKeyAuth
+// @router      /jobs/rearchive/ [get]
+func (api *RestApi) getRearchiveStatus(rw http.ResponseWriter, r *http.Request) {
+	if user := auth.GetUser(r.Context()); user != nil && !user.HasRole(auth.RoleAdmin) {
+		handleError(fmt.Errorf("missing role: %#v", auth.RoleAdmin), http.StatusForbidden, rw)
+		return
+	}
+
+	run := archiver.RearchiveStatus()
+	if run == nil {
+		handleError(errors.New("no jobs were archived again since the start"), http.StatusNotFound, rw)
+		return
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusOK)
+	json.NewEncoder(rw).Encode(run)
+}
+
+// getCacheStatistics godoc
+// @summary     Metric data cache statistics
+// @tags admin
+// @description Returns the number of cache hits and misses since the start for the metric data of jobs
+// @description (jobData), the statistics of running jobs (stats) and the data of nodes (nodeData).
+// @description Requires the admin role.
+// @produce     json
+// @success     200     {array}  api.CacheStatisticsApiResponse "Cache statistics"
+// @failure     401     {object} api.ErrorResponse              "Unauthorized"
+// @failure     403     {object} api.ErrorResponse              "Forbidden"
+// @security    ApiKeyAuth
+// @router      /cache/ [get]
+func (api *RestApi) getCacheStatistics(rw http.ResponseWriter, r *http.Request) {
+	if user := auth.GetUser(r.Context()); user != nil && !user.HasRole(auth.RoleAdmin) {
+		handleError(fmt.Errorf("missing role: %#v", auth.RoleAdmin), http.StatusForbidden, rw)
+		return
+	}
+
+	counters := metricdata.GetCacheCounters()
+	res := make([]CacheStatisticsApiResponse, 0, len(counters))
+	for _, kind := range []string{metricdata.CacheJobData, metricdata.CacheStats, metricdata.CacheNodeData} {
+		res = append(res, CacheStatisticsApiResponse{
+			Kind:   kind,
+			Hits:   counters[kind].Hits,
+			Misses: counters[kind].Misses,
+		})
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusOK)
+	json.NewEncoder(rw).Encode(res)
+}
+
+// getMetricDataHealth godoc
+// @summary     Health of failover chains of metric data repositories
+// @tags admin
+// @description Returns the health of the metric data repositories of every cluster that uses a failover chain
+// @description and how many requests each repository answered. Requires the admin role.
+// @produce     json
+// @success     200     {array}  api.MetricDataHealthApiResponse "Health by cluster"
+// @failure     401     {object} api.ErrorResponse               "Unauthorized"
+// @failure     403     {object} api.ErrorResponse               "Forbidden"
+// @security    ApiKeyAuth
+// @router      /metricdata/health/ [get]
+func (api *RestApi) getMetricDataHealth(rw http.ResponseWriter, r *http.Request) {
+	if user := auth.GetUser(r.Context()); user != nil && !user.HasRole(auth.RoleAdmin) {
+		handleError(fmt.Errorf("missing role: %#v", auth.RoleAdmin), http.StatusForbidden, rw)
+		return
+	}
+
+	epoch := func(t time.Time) int64 {
+		if t.IsZero() {
+			return 0
+		}
+		return t.Unix()
+	}
+
+	health := metricdata.GetRepositoryHealth()
+	clusters := make([]string, 0, len(health))
+	for cluster := range health {
+		clusters = append(clusters, cluster)
+	}
+	sort.Strings(clusters)
+
+	res := make([]MetricDataHealthApiResponse, 0, len(clusters))
+	for _, cluster := range clusters {
+		repos := make([]ApiBackendHealth, 0, len(health[cluster]))
+		for _, h := range health[cluster] {
+			repos = append(repos, ApiBackendHealth{
+				Name:         h.Name,
+				Healthy:      h.Healthy,
+				Failures:     h.Failures,
+				LastError:    h.LastError,
+				LastFailure:  epoch(h.LastFailure),
+				LastSuccess:  epoch(h.LastSuccess),
+				Answered:     h.Answered,
+				LastAnswered: epoch(h.LastAnswered),
+			})
+		}
+		res = append(res, MetricDataHealthApiResponse{Cluster: cluster, Repositories: repos})
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusOK)
+	json.NewEncoder(rw).Encode(res)
+}
+
+// getArchivingQueue godoc
+// @summary     List the archiving queue
+// @tags admin
+// @description Lists the stopped jobs that are not archived yet, oldest first. Jobs are removed from
+// @description the queue once they are archived successfully. Requires the admin role.
+// @produce     json
+// @param       state   query    string                      false "Only entries in this state" Enums(pending, running, failed, abandoned)
+// @success     200     {array}  schema.ArchivingTask        "Queue entries"
+// @failure     400     {object} api.ErrorResponse           "Bad Request"
+// @failure     401     {object} api.ErrorResponse           "Unauthorized"
+// @failure     403     {object} api.ErrorResponse           "Forbidden"
+// @failure     500     {object} api.ErrorResponse           "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /archiving/ [get]
+func (api *RestApi) getArchivingQueue(rw http.ResponseWriter, r *http.Request) {
+	if user := auth.GetUser(r.Context()); user != nil && !user.HasRole(auth.RoleAdmin) {
+		handleError(fmt.Errorf("missing role: %#v", auth.RoleAdmin), http.StatusForbidden, rw)
+		return
+	}
+
+	var state *schema.ArchivingState
+	if val := r.URL.Query().Get("state"); val != "" {
+		s := schema.ArchivingState(val)
+		if !s.Valid() {
+			handleError(fmt.Errorf("invalid state: %#v", val), http.StatusBadRequest, rw)
+			return
+		}
+		state = &s
+	}
+
+	tasks, err := repository.GetArchivingRepository().QueryTasks(state)
+	if err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusOK)
+	json.NewEncoder(rw).Encode(tasks)
+}
+
+// retryArchiving godoc
+// @summary     Retry archiving a job
+// @tags admin
+// @description Resets a queue entry that is not running, archiving the job is attempted again
+// @description immediately with a fresh number of attempts. Requires the admin role.
+// @produce     json
+// @param       id      path     int                         true "ID of the queue entry"
+// @success     200     {object} schema.ArchivingTask        "Updated queue entry"
+// @failure     400     {object} api.ErrorResponse           "Bad Request"
+// @failure     401     {object} api.ErrorResponse           "Unauthorized"
+// @failure     403     {object} api.ErrorResponse           "Forbidden"
+// @failure     404     {object} api.ErrorResponse           "Resource not found"
+// @failure     500     {object} api.ErrorResponse           "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /archiving/retry/{id} [post]
+func (api *RestApi) retryArchiving(rw http.ResponseWriter, r *http.Request) {
+	api.updateArchivingTask(rw, r, archiver.Retry)
+}
+
+// abandonArchiving godoc
+// @summary     Abandon archiving a job
+// @tags admin
+// @description Gives up a queue entry that is not running, the job is marked as failed to archive.
+// @description The entry stays in the queue and can be retried later. Requires the admin role.
+// @produce     json
+// @param       id      path     int                         true "ID of the queue entry"
+// @success     200     {object} schema.ArchivingTask        "Updated queue entry"
+// @failure     400     {object} api.ErrorResponse           "Bad Request"
+// @failure     401     {object} api.ErrorResponse           "Unauthorized"
+// @failure     403     {object} api.ErrorResponse           "Forbidden"
+// @failure     404     {object} api.ErrorResponse           "Resource not found"
+// @failure     500     {object} api.ErrorResponse           "Internal Server Error"
+// @security    ApiKeyAuth
+// @router      /archiving/abandon/{id} [post]
+func (api *RestApi) abandonArchiving(rw http.ResponseWriter, r *http.Request) {
+	api.updateArchivingTask(rw, r, archiver.Abandon)
+}
+
+func (api *RestApi) updateArchivingTask(rw http.ResponseWriter, r *http.Request, update func(id int64) (*schema.ArchivingTask, error)) {
+	if user := auth.GetUser(r.Context()); user != nil && !user.HasRole(auth.RoleAdmin) {
+		handleError(fmt.Errorf("missing role: %#v", auth.RoleAdmin), http.StatusForbidden, rw)
+		return
+	}
+
+	id, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		handleError(fmt.Errorf("integer expected in path for id: %w", err), http.StatusBadRequest, rw)
+		return
+	}
+
+	task, err := update(id)
+	if err == sql.ErrNoRows {
+		handleError(fmt.Errorf("no queue entry with id %d that is not running", id), http.StatusNotFound, rw)
+		return
+	} else if err != nil {
+		handleError(err, http.StatusInternalServerError, rw)
+		return
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusOK)
+	json.NewEncoder(rw).Encode(task)
+}
+
 func (api *RestApi) getJWT(rw http.ResponseWriter, r *http.Request) {
 	rw.Header().Set("Content-Type", "text/plain")
 	username := r.FormValue("username")
@@ -985,3 +1468,55 @@ func (api *RestApi) getMachineState(rw http.ResponseWriter, r *http.Request) {
 	// Sets the content-type and 'Last-Modified' Header and so on automatically
 	http.ServeFile(rw, r, filename)
 }
+
+// writeMetrics godoc
+// @summary     Write metric data
+// @tags        metrics
+// @description Stores metric data in InfluxDB line protocol (as sent by the cc-metric-collector) in the
+// @description embedded metric store. The measurement is the metric, the value has to be in the field `value`.
+// @description The tags `hostname`, `type`, `type-id` and `cluster` are used. Only available if the
+// @description `metric-store` option is set. Requires the api role.
+// @accept      plain
+// @produce     json
+// @param       request   body     string                      true  "Metric data in InfluxDB line protocol"
+// @param       cluster   query    string                      false "Cluster of lines without a cluster tag"
+// @param       precision query    string                      false "Unit of the timestamps: s (default), ms, us or ns"
+// @success     200       {object} api.WriteMetricsApiResponse "Number of values stored and dropped"
+// @failure     400       {object} api.ErrorResponse           "Bad Request"
+// @failure     401       {object} api.ErrorResponse           "Unauthorized"
+// @failure     403       {object} api.ErrorResponse           "Forbidden"
+// @security    ApiKeyAuth
+// @router      /write/ [post]
+func (api *RestApi) writeMetrics(rw http.ResponseWriter, r *http.Request) {
+	if user := auth.GetUser(r.Context()); user != nil && !user.HasRole(auth.RoleApi) {
+		handleError(fmt.Errorf("missing role: %#v", auth.RoleApi), http.StatusForbidden, rw)
+		return
+	}
+
+	precision := time.Second
+	switch p := r.URL.Query().Get("precision"); p {
+	case "", "s":
+	case "ms":
+		precision = time.Millisecond
+	case "us":
+		precision = time.Microsecond
+	case "ns":
+		precision = time.Nanosecond
+	default:
+		handleError(fmt.Errorf("invalid precision: %#v", p), http.StatusBadRequest, rw)
+		return
+	}
+
+	stored, dropped, err := metricstore.Write(r.URL.Query().Get("cluster"), bufio.NewReader(r.Body), precision)
+	if err != nil {
+		handleError(fmt.Errorf("parsing the metric data failed (%d values stored before): %w", stored, err), http.StatusBadRequest, rw)
+		return
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusOK)
+	json.NewEncoder(rw).Encode(WriteMetricsApiResponse{
+		Stored:  stored,
+		Dropped: dropped,
+	})
+}
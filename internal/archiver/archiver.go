@@ -0,0 +1,267 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Package archiver archives stopped jobs. The jobs are queued in the database (see
+// repository.ArchivingRepository) and archived by a pool of workers. Failed attempts
+// are retried with exponential backoff, pending work is resumed after a restart.
+package archiver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/internal/config"
+	"github.com/ClusterCockpit/cc-backend/internal/metricdata"
+	"github.com/ClusterCockpit/cc-backend/internal/repository"
+	"github.com/ClusterCockpit/cc-backend/internal/tagger"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+const (
+	// Delay before the second attempt, doubled for every further attempt.
+	retryBaseDelay = 30 * time.Second
+	retryMaxDelay  = time.Hour
+
+	// Idle workers look for due entries at least this often.
+	pollInterval = time.Minute
+)
+
+var (
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stop      chan struct{} = make(chan struct{})
+	wake      chan struct{} = make(chan struct{}, 1)
+	workers   sync.WaitGroup
+
+	// Callbacks waiting for the next attempt of a job.
+	attemptCallbacks     map[int64][]func() = map[int64][]func(){}
+	attemptCallbacksLock sync.Mutex
+)
+
+// Start starts the workers. Entries that were running when the previous process
+// stopped are attempted again. Calling Start more than once has no effect.
+func Start() {
+	startOnce.Do(func() {
+		queue := repository.GetArchivingRepository()
+		n, err := queue.ResetRunning(time.Now())
+		if err != nil {
+			log.Errorf("archiving: resuming interrupted attempts failed: %s", err.Error())
+		} else if n > 0 {
+			log.Infof("archiving: resuming %d interrupted attempts", n)
+		}
+
+		numWorkers := config.Keys.ArchiveWorkers
+		if numWorkers < 1 {
+			numWorkers = 1
+		}
+		for i := 0; i < numWorkers; i++ {
+			workers.Add(1)
+			go worker(queue, repository.GetJobRepository())
+		}
+	})
+}
+
+// Shutdown waits for the ongoing attempts. Pending entries stay in the
+// queue and are resumed by the next call to Start (in the next process).
+// Archiving jobs again (see StartRearchive) is aborted.
+func Shutdown() {
+	stopOnce.Do(func() { close(stop) })
+	stopRearchive()
+	workers.Wait()
+}
+
+// Enqueue adds a stopped job to the queue and starts the workers if needed. If `attempted` is
+// not nil, it is called once the first attempt to archive the job has finished.
+func Enqueue(job *schema.Job, attempted func()) error {
+	if attempted != nil {
+		attemptCallbacksLock.Lock()
+		attemptCallbacks[job.ID] = append(attemptCallbacks[job.ID], attempted)
+		attemptCallbacksLock.Unlock()
+	}
+
+	if err := repository.GetArchivingRepository().Enqueue(job.ID, time.Now()); err != nil {
+		attemptCallbacksLock.Lock()
+		delete(attemptCallbacks, job.ID)
+		attemptCallbacksLock.Unlock()
+		return err
+	}
+
+	Start()
+	notify()
+	return nil
+}
+
+// Retry makes the next attempt for a failed or abandoned entry due immediately. The attempt
+// counter is reset. To check if there is no such entry that is not running test err == sql.ErrNoRows
+func Retry(id int64) (*schema.ArchivingTask, error) {
+	task, err := repository.GetArchivingRepository().Retry(id, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repository.GetJobRepository().UpdateMonitoringStatus(task.JobID, schema.MonitoringStatusRunningOrArchiving); err != nil {
+		return nil, err
+	}
+
+	Start()
+	notify()
+	return task, nil
+}
+
+// Abandon gives up archiving the job of an entry that is not running, the job is marked as failed.
+// To check if there is no such entry that is not running test err == sql.ErrNoRows
+func Abandon(id int64) (*schema.ArchivingTask, error) {
+	task, err := repository.GetArchivingRepository().Abandon(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := repository.GetJobRepository().UpdateMonitoringStatus(task.JobID, schema.MonitoringStatusArchivingFailed); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// notify wakes up an idle worker.
+func notify() {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
+
+func worker(queue *repository.ArchivingRepository, jobRepo *repository.JobRepository) {
+	defer workers.Done()
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		task, err := queue.Claim(time.Now())
+		if err != nil {
+			log.Errorf("archiving: reading the queue failed: %s", err.Error())
+		} else if task != nil {
+			process(queue, jobRepo, task)
+			continue
+		}
+
+		delay := pollInterval
+		if next, err := queue.NextAttempt(); err == nil && !next.IsZero() && time.Until(next) < delay {
+			delay = time.Until(next)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-stop:
+			timer.Stop()
+			return
+		case <-wake:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+// process makes one attempt for an entry and updates the entry accordingly.
+func process(queue *repository.ArchivingRepository, jobRepo *repository.JobRepository, task *schema.ArchivingTask) {
+	defer attempted(task.JobID)
+
+	err := archiveJob(jobRepo, task.JobID)
+	if err == nil {
+		if err := queue.Done(task.ID); err != nil {
+			log.Errorf("archiving: removing the queue entry of job (dbid: %d) failed: %s", task.JobID, err.Error())
+		}
+		log.Printf("archiving job (dbid: %d) successful", task.JobID)
+		return
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Warnf("archiving: job (dbid: %d) does not exist anymore, removing it from the queue", task.JobID)
+		if err := queue.Done(task.ID); err != nil {
+			log.Errorf("archiving: removing the queue entry of job (dbid: %d) failed: %s", task.JobID, err.Error())
+		}
+		return
+	}
+
+	maxAttempts := config.Keys.ArchiveMaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	if task.Attempts >= maxAttempts {
+		log.Errorf("archiving job (dbid: %d) failed (attempt %d, giving up): %s", task.JobID, task.Attempts, err.Error())
+		if err := queue.Failed(task.ID, err, nil); err != nil {
+			log.Errorf("archiving: updating the queue entry of job (dbid: %d) failed: %s", task.JobID, err.Error())
+		}
+		jobRepo.UpdateMonitoringStatus(task.JobID, schema.MonitoringStatusArchivingFailed)
+		return
+	}
+
+	next := time.Now().Add(backoff(task.Attempts))
+	log.Warnf("archiving job (dbid: %d) failed (attempt %d of %d, next attempt at %s): %s",
+		task.JobID, task.Attempts, maxAttempts, next.Format(time.RFC3339), err.Error())
+	if err := queue.Failed(task.ID, err, &next); err != nil {
+		log.Errorf("archiving: updating the queue entry of job (dbid: %d) failed: %s", task.JobID, err.Error())
+	}
+}
+
+// backoff returns the delay after the failed attempt number `attempts`.
+func backoff(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+	return delay
+}
+
+// archiveJob fetches all the data of a job from its MetricDataRepository, writes it to
+// the job-archive and updates the database entry of the job one last time.
+func archiveJob(jobRepo *repository.JobRepository, jobId int64) error {
+	job, err := jobRepo.FindById(jobId)
+	if err != nil {
+		return err
+	}
+	if job.State == schema.JobStateRunning {
+		return fmt.Errorf("the job is still running")
+	}
+
+	if _, err := jobRepo.FetchMetadata(job); err != nil {
+		return err
+	}
+
+	jobMeta, err := metricdata.ArchiveJob(job, context.Background())
+	if err != nil {
+		return err
+	}
+
+	if err := jobRepo.Archive(job.ID, schema.MonitoringStatusArchivingSuccessful, jobMeta.Statistics); err != nil {
+		return err
+	}
+
+	if _, err := tagger.TagJob(jobRepo, job, jobMeta.Statistics); err != nil {
+		log.Errorf("applying the job rules to job (dbid: %d) failed: %s", job.ID, err.Error())
+	}
+	return nil
+}
+
+func attempted(jobId int64) {
+	attemptCallbacksLock.Lock()
+	callbacks := attemptCallbacks[jobId]
+	delete(attemptCallbacks, jobId)
+	attemptCallbacksLock.Unlock()
+
+	for _, callback := range callbacks {
+		callback()
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package archiver
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	expected := []time.Duration{30 * time.Second, time.Minute, 2 * time.Minute, 4 * time.Minute}
+	for i, delay := range expected {
+		if d := backoff(i + 1); d != delay {
+			t.Errorf("attempt %d: expected %s, got %s", i+1, delay, d)
+		}
+	}
+
+	if d := backoff(20); d != retryMaxDelay {
+		t.Errorf("expected the maximum delay, got %s", d)
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package archiver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
+	"github.com/ClusterCockpit/cc-backend/internal/metricdata"
+	"github.com/ClusterCockpit/cc-backend/internal/repository"
+	"github.com/ClusterCockpit/cc-backend/internal/tagger"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+// Results kept of a run in the background.
+const maxRearchiveResults = 1000
+
+var (
+	errNotArchived = errors.New("the job is running, not archived yet or not monitored")
+
+	// ErrRearchiveRunning is returned by StartRearchive if a run is in progress already.
+	ErrRearchiveRunning = errors.New("jobs are archived again already")
+
+	rearchiveRun    *schema.RearchiveRun
+	rearchiveLock   sync.Mutex
+	rearchiveDone   sync.WaitGroup
+	rearchiveCancel context.CancelFunc = func() {}
+)
+
+// StartRearchive starts archiving the jobs matching the filters again in the background (see Rearchive).
+// Only one run can be in progress at a time. A shutdown aborts the run.
+func StartRearchive(filters []*model.JobFilter, dryRun bool) (*schema.RearchiveRun, error) {
+	rearchiveLock.Lock()
+	defer rearchiveLock.Unlock()
+
+	if rearchiveRun != nil && rearchiveRun.Running {
+		return nil, ErrRearchiveRunning
+	}
+
+	run := &schema.RearchiveRun{
+		DryRun:    dryRun,
+		Running:   true,
+		StartTime: time.Now(),
+		Results:   make([]*schema.RearchiveResult, 0),
+	}
+	rearchiveRun = run
+	ctx, cancel := context.WithCancel(context.Background())
+	rearchiveCancel = cancel
+
+	rearchiveDone.Add(1)
+	go func(ctx context.Context) {
+		defer rearchiveDone.Done()
+		summary, err := Rearchive(ctx, filters, dryRun, func(n int, summary *schema.RearchiveSummary, res *schema.RearchiveResult) {
+			rearchiveLock.Lock()
+			defer rearchiveLock.Unlock()
+			run.Processed, run.Summary = n, *summary
+			if len(run.Results) == maxRearchiveResults {
+				run.Results = run.Results[1:]
+			}
+			run.Results = append(run.Results, res)
+		})
+
+		rearchiveLock.Lock()
+		defer rearchiveLock.Unlock()
+		run.Running = false
+		if summary != nil {
+			run.Summary = *summary
+		}
+		if err != nil {
+			run.Error = err.Error()
+			log.Errorf("re-archive: aborted after %d jobs: %s", run.Processed, err.Error())
+		} else {
+			log.Infof("re-archive: %d jobs done, %d skipped, %d failed", run.Summary.Done, run.Summary.Skipped, run.Summary.Failed)
+		}
+	}(ctx)
+
+	return copyRun(run), nil
+}
+
+// RearchiveStatus returns the progress of the current or last run started by StartRearchive, nil if there is none.
+func RearchiveStatus() *schema.RearchiveRun {
+	rearchiveLock.Lock()
+	defer rearchiveLock.Unlock()
+
+	if rearchiveRun == nil {
+		return nil
+	}
+	return copyRun(rearchiveRun)
+}
+
+func copyRun(run *schema.RearchiveRun) *schema.RearchiveRun {
+	cpy := *run
+	cpy.Results = append(make([]*schema.RearchiveResult, 0, len(run.Results)), run.Results...)
+	return &cpy
+}
+
+// stopRearchive aborts a run in the background and waits for it.
+func stopRearchive() {
+	rearchiveLock.Lock()
+	rearchiveCancel()
+	rearchiveLock.Unlock()
+	rearchiveDone.Wait()
+}
+
+// Rearchive loads the metric data of all archived jobs matching the filters from the metric data
+// repositories again, recomputes their statistics, overwrites the job-archive and updates the
+// database. Only jobs whose data is still completely available in the metric data repository are
+// changed, the others are skipped.
+// With `dryRun`, only the statistics are computed. `progress` is called after every job.
+func Rearchive(
+	ctx context.Context,
+	filters []*model.JobFilter,
+	dryRun bool,
+	progress func(n int, summary *schema.RearchiveSummary, res *schema.RearchiveResult)) (*schema.RearchiveSummary, error) {
+
+	const pageSize = 1000
+
+	jobRepo := repository.GetJobRepository()
+
+	// The matching jobs are collected first, updating the database can change what matches.
+	ids := make([]int64, 0)
+	order := []*model.OrderByInput{{Field: model.SortByAttributeID, Order: model.SortDirectionEnumAsc}}
+	for page := 1; ; page++ {
+		jobs, err := jobRepo.QueryJobs(ctx, filters, &model.PageRequest{Page: page, ItemsPerPage: pageSize}, order)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, job := range jobs {
+			ids = append(ids, job.ID)
+		}
+		if len(jobs) < pageSize {
+			break
+		}
+	}
+
+	summary := &schema.RearchiveSummary{Total: len(ids)}
+	for i, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return summary, err
+		}
+
+		res, err := rearchiveJob(ctx, jobRepo, id, dryRun)
+		if err != nil {
+			res.Error = err.Error()
+			if errors.Is(err, errNotArchived) || errors.Is(err, metricdata.ErrNoMetricData) ||
+				errors.Is(err, metricdata.ErrIncompleteMetricData) {
+				res.Skipped = true
+				summary.Skipped += 1
+			} else {
+				summary.Failed += 1
+			}
+		} else {
+			summary.Done += 1
+		}
+
+		if progress != nil {
+			progress(i+1, summary, res)
+		}
+	}
+
+	return summary, nil
+}
+
+// HandleRearchiveFlag archives the jobs matching `filter` (a JSON encoded JobFilter like
+// `{"cluster": {"eq": "fritz"}}`) again and prints the progress.
+func HandleRearchiveFlag(filter string, dryRun bool) error {
+	dec := json.NewDecoder(strings.NewReader(filter))
+	dec.DisallowUnknownFields()
+	f := &model.JobFilter{}
+	if err := dec.Decode(f); err != nil {
+		return fmt.Errorf("invalid job filter: %w", err)
+	}
+
+	prefix := "re-archive"
+	if dryRun {
+		prefix = "re-archive (dry run)"
+	}
+	summary, err := Rearchive(context.Background(), []*model.JobFilter{f}, dryRun, func(n int, summary *schema.RearchiveSummary, res *schema.RearchiveResult) {
+		switch {
+		case res.Skipped:
+			log.Infof("%s [%d/%d]: job %d (dbid: %d) on %s skipped: %s", prefix, n, summary.Total, res.JobID, res.ID, res.Cluster, res.Error)
+		case res.Error != "":
+			log.Errorf("%s [%d/%d]: job %d (dbid: %d) on %s failed: %s", prefix, n, summary.Total, res.JobID, res.ID, res.Cluster, res.Error)
+		default:
+			log.Infof("%s [%d/%d]: job %d (dbid: %d) on %s done (%d metrics)", prefix, n, summary.Total, res.JobID, res.ID, res.Cluster, len(res.Statistics))
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Infof("%s: %d jobs done, %d skipped, %d failed", prefix, summary.Done, summary.Skipped, summary.Failed)
+	return nil
+}
+
+func rearchiveJob(ctx context.Context, jobRepo *repository.JobRepository, id int64, dryRun bool) (*schema.RearchiveResult, error) {
+	res := &schema.RearchiveResult{ID: id}
+	job, err := jobRepo.FindById(id)
+	if err != nil {
+		return res, err
+	}
+	res.JobID, res.Cluster, res.StartTime = job.JobID, job.Cluster, job.StartTime.Unix()
+
+	// Jobs still in the archiving queue are left to it.
+	if job.State == schema.JobStateRunning ||
+		job.MonitoringStatus == schema.MonitoringStatusDisabled ||
+		job.MonitoringStatus == schema.MonitoringStatusRunningOrArchiving {
+		return res, errNotArchived
+	}
+
+	if _, err := jobRepo.FetchMetadata(job); err != nil {
+		return res, err
+	}
+
+	jobMeta, err := metricdata.RearchiveJob(job, ctx, dryRun)
+	if err != nil {
+		return res, err
+	}
+	res.Statistics = jobMeta.Statistics
+	if dryRun {
+		return res, nil
+	}
+
+	if err := jobRepo.Archive(job.ID, schema.MonitoringStatusArchivingSuccessful, jobMeta.Statistics); err != nil {
+		return res, err
+	}
+
+	// The job might have failed to archive before.
+	if err := repository.GetArchivingRepository().DoneJob(job.ID); err != nil {
+		return res, err
+	}
+
+	if _, err := tagger.TagJob(jobRepo, job, jobMeta.Statistics); err != nil {
+		return res, err
+	}
+	return res, nil
+}
@@ -0,0 +1,325 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Package expression parses and evaluates the arithmetic expressions used for derived
+// metrics (like `flops_dp*2 + flops_sp`) and job rules (like `flops_any.avg < 0.1 * flops_any.peak`).
+package expression
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"unicode"
+)
+
+// An Expression is a parsed expression. Supported are numbers, identifiers (letters, digits,
+// `_` and `.`), the arithmetic operators +, -, * and /, the comparisons <, <=, >, >=, == and !=,
+// the logical operators &&, || and ! and parentheses. Comparisons and logical operators evaluate
+// to 1 (true) or 0 (false), every value other than 0 and NaN is true.
+type Expression struct {
+	Source string
+
+	// The identifiers used, values passed to Eval are in the same order.
+	Operands []string
+
+	root node
+}
+
+type node interface {
+	eval(values []float64) float64
+}
+
+type number float64
+
+type operand int
+
+type unary struct {
+	op byte
+	x  node
+}
+
+type binary struct {
+	op   string
+	x, y node
+}
+
+func (n number) eval(_ []float64) float64 {
+	return float64(n)
+}
+
+func (n operand) eval(values []float64) float64 {
+	return values[n]
+}
+
+func (n unary) eval(values []float64) float64 {
+	x := n.x.eval(values)
+	if n.op == '!' {
+		return boolean(!IsTrue(x))
+	}
+	return -x
+}
+
+func (n binary) eval(values []float64) float64 {
+	x := n.x.eval(values)
+	switch n.op {
+	case "&&":
+		if !IsTrue(x) {
+			return 0
+		}
+		return boolean(IsTrue(n.y.eval(values)))
+	case "||":
+		if IsTrue(x) {
+			return 1
+		}
+		return boolean(IsTrue(n.y.eval(values)))
+	}
+
+	y := n.y.eval(values)
+	switch n.op {
+	case "+":
+		return x + y
+	case "-":
+		return x - y
+	case "*":
+		return x * y
+	case "/":
+		return x / y
+	case "<":
+		return boolean(x < y)
+	case "<=":
+		return boolean(x <= y)
+	case ">":
+		return boolean(x > y)
+	case ">=":
+		return boolean(x >= y)
+	case "==":
+		return boolean(x == y)
+	default:
+		return boolean(x != y)
+	}
+}
+
+func boolean(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// IsTrue returns the truth value of the result of an expression.
+func IsTrue(x float64) bool {
+	return x != 0 && !math.IsNaN(x)
+}
+
+// Eval evaluates the expression, `values` contains the values of the operands.
+func (e *Expression) Eval(values []float64) float64 {
+	return e.root.eval(values)
+}
+
+type parser struct {
+	src      []rune
+	pos      int
+	expr     *Expression
+	operands map[string]int
+}
+
+// Parse parses `src`. Expressions without any operands are rejected.
+func Parse(src string) (*Expression, error) {
+	p := &parser{
+		src:      []rune(src),
+		expr:     &Expression{Source: src},
+		operands: map[string]int{},
+	}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos != len(p.src) {
+		return nil, p.errorf("unexpected %#v", string(p.src[p.pos]))
+	}
+	if len(p.expr.Operands) == 0 {
+		return nil, fmt.Errorf("invalid expression %#v: nothing referenced", src)
+	}
+
+	p.expr.root = root
+	return p.expr, nil
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("invalid expression %#v at position %d: %s", string(p.src), p.pos+1, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.src) && unicode.IsSpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *parser) peek() rune {
+	p.skipSpace()
+	if p.pos == len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+// consume skips `op` if it comes next.
+func (p *parser) consume(op string) bool {
+	p.skipSpace()
+	ops := []rune(op)
+	if p.pos+len(ops) > len(p.src) || string(p.src[p.pos:p.pos+len(ops)]) != op {
+		return false
+	}
+	p.pos += len(ops)
+	return true
+}
+
+// or := and ('||' and)*
+func (p *parser) parseOr() (node, error) {
+	x, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.consume("||") {
+		y, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		x = binary{op: "||", x: x, y: y}
+	}
+	return x, nil
+}
+
+// and := comparison ('&&' comparison)*
+func (p *parser) parseAnd() (node, error) {
+	x, err := p.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.consume("&&") {
+		y, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+		x = binary{op: "&&", x: x, y: y}
+	}
+	return x, nil
+}
+
+// comparison := sum (('<' | '<=' | '>' | '>=' | '==' | '!=') sum)?
+func (p *parser) parseComparison() (node, error) {
+	x, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+
+	// Two character operators first:
+	for _, op := range []string{"<=", ">=", "==", "!=", "<", ">"} {
+		if p.consume(op) {
+			y, err := p.parseSum()
+			if err != nil {
+				return nil, err
+			}
+			return binary{op: op, x: x, y: y}, nil
+		}
+	}
+	return x, nil
+}
+
+// sum := product (('+' | '-') product)*
+func (p *parser) parseSum() (node, error) {
+	x, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+
+	for c := p.peek(); c == '+' || c == '-'; c = p.peek() {
+		p.pos++
+		y, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		x = binary{op: string(c), x: x, y: y}
+	}
+	return x, nil
+}
+
+// product := unary (('*' | '/') unary)*
+func (p *parser) parseProduct() (node, error) {
+	x, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for c := p.peek(); c == '*' || c == '/'; c = p.peek() {
+		p.pos++
+		y, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		x = binary{op: string(c), x: x, y: y}
+	}
+	return x, nil
+}
+
+// unary := ('-' | '!') unary | '(' or ')' | number | identifier
+func (p *parser) parseUnary() (node, error) {
+	c := p.peek()
+	switch {
+	case c == '-' || c == '!':
+		p.pos++
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return unary{op: byte(c), x: x}, nil
+	case c == '(':
+		p.pos++
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ')' {
+			return nil, p.errorf("expected ')'")
+		}
+		p.pos++
+		return x, nil
+	case unicode.IsDigit(c) || c == '.':
+		start := p.pos
+		for p.pos < len(p.src) && (unicode.IsDigit(p.src[p.pos]) || p.src[p.pos] == '.' ||
+			p.src[p.pos] == 'e' || p.src[p.pos] == 'E' ||
+			((p.src[p.pos] == '+' || p.src[p.pos] == '-') && (p.src[p.pos-1] == 'e' || p.src[p.pos-1] == 'E'))) {
+			p.pos++
+		}
+		x, err := strconv.ParseFloat(string(p.src[start:p.pos]), 64)
+		if err != nil {
+			p.pos = start
+			return nil, p.errorf("invalid number")
+		}
+		return number(x), nil
+	case unicode.IsLetter(c) || c == '_':
+		start := p.pos
+		for p.pos < len(p.src) && (unicode.IsLetter(p.src[p.pos]) || unicode.IsDigit(p.src[p.pos]) ||
+			p.src[p.pos] == '_' || p.src[p.pos] == '.') {
+			p.pos++
+		}
+		name := string(p.src[start:p.pos])
+		idx, ok := p.operands[name]
+		if !ok {
+			idx = len(p.expr.Operands)
+			p.operands[name] = idx
+			p.expr.Operands = append(p.expr.Operands, name)
+		}
+		return operand(idx), nil
+	case c == 0:
+		return nil, p.errorf("unexpected end")
+	default:
+		return nil, p.errorf("unexpected %#v", string(c))
+	}
+}
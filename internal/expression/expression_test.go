@@ -0,0 +1,52 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package expression
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		src      string
+		operands []string
+		values   []float64
+		result   float64
+	}{
+		{"flops_dp*2 + flops_sp", []string{"flops_dp", "flops_sp"}, []float64{3, 4}, 10},
+		{"(rd+wr)*64", []string{"rd", "wr"}, []float64{1, 2}, 192},
+		{"a - b - c", []string{"a", "b", "c"}, []float64{10, 3, 2}, 5},
+		{"a / 2 / b", []string{"a", "b"}, []float64{8, 2}, 2},
+		{"-a * -(b + 1e1)", []string{"a", "b"}, []float64{2, 1}, 22},
+		{"a*a + 1.5E+1", []string{"a"}, []float64{3}, 24},
+		{"flops_any.avg < 0.1 * flops_any.peak", []string{"flops_any.avg", "flops_any.peak"}, []float64{1, 100}, 1},
+		{"a >= 2 && b != 3 || c", []string{"a", "b", "c"}, []float64{2, 3, 0}, 0},
+		{"a >= 2 && b != 3 || c", []string{"a", "b", "c"}, []float64{2, 3, 5}, 1},
+		{"!(a == 1) && a <= 2", []string{"a"}, []float64{2}, 1},
+		{"a > 1", []string{"a"}, []float64{math.NaN()}, 0},
+	}
+
+	for _, test := range tests {
+		expr, err := Parse(test.src)
+		if err != nil {
+			t.Errorf("%s: %s", test.src, err.Error())
+			continue
+		}
+		if strings.Join(expr.Operands, ",") != strings.Join(test.operands, ",") {
+			t.Errorf("%s: unexpected operands %v", test.src, expr.Operands)
+		}
+		if res := expr.Eval(test.values); res != test.result {
+			t.Errorf("%s: expected %f, got %f", test.src, test.result, res)
+		}
+	}
+
+	for _, src := range []string{"", "a +", "(a + b", "a b", "2 * 3", "a $ b", "1.2.3 * a", "a < b < c", "a & b", "a =! b"} {
+		if _, err := Parse(src); err == nil {
+			t.Errorf("%#v: expected an error", src)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
+	"github.com/ClusterCockpit/cc-backend/internal/metricdata"
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+// Helper function for the compareJobs GraphQL query placed here so that schema.resolvers.go is not too full.
+// The statistics are taken from the job-archive (`JobMeta.Statistics`), those of jobs that are not archived
+// are calculated from their node scope data.
+func (r *queryResolver) compareJobs(
+	ctx context.Context,
+	ids []string,
+	metrics []string,
+	averageNodes bool,
+	resolution int) (*model.JobComparison, error) {
+
+	if len(ids) < 2 {
+		return nil, errors.New("at least two jobs are needed for a comparison")
+	}
+	if len(ids) > MAX_JOBS_FOR_ANALYSIS {
+		return nil, fmt.Errorf("too many jobs to compare (max: %d)", MAX_JOBS_FOR_ANALYSIS)
+	}
+
+	// Query.job rejects jobs the user is not allowed to see.
+	jobs := make([]*schema.Job, 0, len(ids))
+	for _, id := range ids {
+		job, err := r.Query().Job(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+
+	if metrics == nil {
+		seen := map[string]bool{}
+		for _, job := range jobs {
+			cluster := archive.GetCluster(job.Cluster)
+			if cluster == nil {
+				continue
+			}
+			for _, mc := range cluster.MetricConfig {
+				if !seen[mc.Name] {
+					seen[mc.Name] = true
+					metrics = append(metrics, mc.Name)
+				}
+			}
+		}
+	}
+
+	res := &model.JobComparison{Jobs: jobs, Metrics: make([]*model.MetricComparison, 0, len(metrics))}
+	comparisons := make(map[string]*model.MetricComparison, len(metrics))
+	for _, metric := range metrics {
+		mc := &model.MetricComparison{
+			Name:         metric,
+			Statistics:   make([]*schema.JobStatistics, len(jobs)),
+			RelativeDiff: make([]schema.Float, len(jobs)),
+			Series:       make([]*model.ComparedSeries, 0),
+		}
+		comparisons[metric] = mc
+		res.Metrics = append(res.Metrics, mc)
+	}
+
+	scopes := []schema.MetricScope{schema.MetricScopeNode}
+	for i, job := range jobs {
+		data, err := metricdata.LoadData(job, metrics, scopes, ctx, resolution)
+		if err != nil {
+			return nil, err
+		}
+
+		var archived map[string]schema.JobStatistics
+		if job.State != schema.JobStateRunning && job.MonitoringStatus == schema.MonitoringStatusArchivingSuccessful {
+			if archived, err = archive.GetStatistics(job); err != nil {
+				log.Warnf("no statistics of job %d in the archive: %s", job.ID, err.Error())
+			}
+		}
+
+		id := strconv.FormatInt(job.ID, 10)
+		for _, metric := range metrics {
+			mc := comparisons[metric]
+			jm := data[metric][schema.MetricScopeNode]
+			if stats, ok := archived[metric]; ok {
+				mc.Statistics[i] = &stats
+			} else if jm != nil {
+				mc.Statistics[i] = seriesStatistics(job, jm)
+			}
+
+			if mc.Unit == "" {
+				if mc.Statistics[i] != nil && mc.Statistics[i].Unit != "" {
+					mc.Unit = mc.Statistics[i].Unit
+				} else if jm != nil {
+					mc.Unit = jm.Unit
+				}
+			}
+
+			if jm == nil {
+				continue
+			}
+			if averageNodes {
+				mc.Series = append(mc.Series, &model.ComparedSeries{
+					Job:      id,
+					Timestep: jm.Timestep,
+					Data:     averageSeries(jm.Series),
+				})
+				continue
+			}
+			for j := range jm.Series {
+				series := &jm.Series[j]
+				mc.Series = append(mc.Series, &model.ComparedSeries{
+					Job:      id,
+					Hostname: &series.Hostname,
+					Timestep: jm.Timestep,
+					Data:     series.Data,
+				})
+			}
+		}
+	}
+
+	for _, mc := range res.Metrics {
+		for i, stats := range mc.Statistics {
+			baseline := mc.Statistics[0]
+			if stats == nil || baseline == nil || baseline.Avg == 0 {
+				mc.RelativeDiff[i] = schema.NaN
+				continue
+			}
+			mc.RelativeDiff[i] = schema.Float((stats.Avg - baseline.Avg) / baseline.Avg)
+		}
+	}
+
+	return res, nil
+}
+
+// seriesStatistics calculates the statistics of a job like they are stored in the archive
+// (average over all nodes, minimum and maximum of any node) from the node scope data.
+func seriesStatistics(job *schema.Job, jm *schema.JobMetric) *schema.JobStatistics {
+	avg, min, max, n := 0.0, math.MaxFloat64, -math.MaxFloat64, 0
+	for _, series := range jm.Series {
+		if series.Statistics == nil {
+			continue
+		}
+		avg += series.Statistics.Avg
+		min = math.Min(min, series.Statistics.Min)
+		max = math.Max(max, series.Statistics.Max)
+		n += 1
+	}
+	if n == 0 {
+		return nil
+	}
+
+	numNodes := int(job.NumNodes)
+	if numNodes < n {
+		numNodes = n
+	}
+	return &schema.JobStatistics{Unit: jm.Unit, Avg: avg / float64(numNodes), Min: min, Max: max}
+}
+
+// averageSeries returns the average over all series timestep by timestep, missing values are ignored.
+func averageSeries(series []schema.Series) []schema.Float {
+	length := 0
+	for _, s := range series {
+		if len(s.Data) > length {
+			length = len(s.Data)
+		}
+	}
+
+	avg := make([]schema.Float, length)
+	for i := range avg {
+		sum, n := 0.0, 0
+		for _, s := range series {
+			if i < len(s.Data) && !s.Data[i].IsNaN() {
+				sum += float64(s.Data[i])
+				n += 1
+			}
+		}
+		if n == 0 {
+			avg[i] = schema.NaN
+		} else {
+			avg[i] = schema.Float(sum / float64(n))
+		}
+	}
+	return avg
+}
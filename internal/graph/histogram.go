@@ -0,0 +1,373 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
+	"github.com/ClusterCockpit/cc-backend/internal/metricdata"
+	"github.com/ClusterCockpit/cc-backend/internal/repository"
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	sq "github.com/Masterminds/squirrel"
+)
+
+// Numeric columns of the job table that the jobsHistogram GraphQL query accepts as value
+// (besides `duration`, which is computed for running jobs, and the footprint metrics).
+var histogramColumns = map[string]string{
+	"walltime":     "job.walltime",
+	"numNodes":     "job.num_nodes",
+	"numHWThreads": "job.num_hwthreads",
+	"numAcc":       "job.num_acc",
+}
+
+var defaultPercentiles = []float64{25, 50, 75, 90, 99}
+
+const (
+	defaultHistogramBins int = 10
+	maxHistogramBins     int = 1000
+)
+
+// Helper function for the jobsHistogram GraphQL query placed here so that schema.resolvers.go is not too full.
+// Job properties and footprints stored in the job table are read by the database, the footprints of other
+// metrics and other statistics are loaded like for the jobsFootprints query (at most MAX_JOBS_FOR_ANALYSIS jobs).
+func (r *queryResolver) jobsHistogram(
+	ctx context.Context,
+	filter []*model.JobFilter,
+	value string,
+	statistic *model.MetricStatistic,
+	bins *model.HistogramBins,
+	percentiles []float64,
+	groupBy *model.Aggregate) ([]*model.Histogram, error) {
+
+	if bins == nil {
+		bins = &model.HistogramBins{}
+	}
+	if percentiles == nil {
+		percentiles = defaultPercentiles
+	}
+	for _, p := range percentiles {
+		if p < 0 || p > 100 {
+			return nil, fmt.Errorf("invalid percentile: %f", p)
+		}
+	}
+
+	if statistic != nil {
+		if !statistic.IsValid() {
+			return nil, fmt.Errorf("invalid statistic: %#v", *statistic)
+		}
+		if _, ok := histogramColumns[value]; ok || value == "duration" {
+			return nil, fmt.Errorf("a statistic can only be selected for metrics, not for %#v", value)
+		}
+	}
+
+	var values map[string][]float64
+	var err error
+	if value == "duration" {
+		values, err = r.histogramColumnValues(ctx, filter, runningDurationColumn(), groupBy)
+	} else if col, ok := histogramColumns[value]; ok {
+		values, err = r.histogramColumnValues(ctx, filter, col, groupBy)
+	} else if col, ok := footprint2column[value]; ok && (statistic == nil || footprintStatistic(col) == *statistic) {
+		values, err = r.histogramColumnValues(ctx, filter, col, groupBy)
+	} else if isConfiguredMetric(value) {
+		if statistic == nil {
+			avg := model.MetricStatisticAvg
+			statistic = &avg
+		}
+		values, err = r.histogramMetricValues(ctx, filter, value, *statistic, groupBy)
+	} else {
+		return nil, fmt.Errorf("unknown histogram value: %#v", value)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(values))
+	for id := range values {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	res := make([]*model.Histogram, 0, len(ids))
+	for _, id := range ids {
+		data := values[id]
+		sort.Float64s(data)
+
+		histogram := &model.Histogram{
+			ID:          id,
+			Count:       len(data),
+			Percentiles: make([]*model.Percentile, 0, len(percentiles)),
+		}
+		if histogram.Bins, err = histogramBins(data, bins); err != nil {
+			return nil, err
+		}
+		if len(data) > 0 {
+			for _, p := range percentiles {
+				histogram.Percentiles = append(histogram.Percentiles, &model.Percentile{
+					Percentile: p,
+					Value:      percentile(data, p),
+				})
+			}
+		}
+		res = append(res, histogram)
+	}
+
+	return res, nil
+}
+
+// histogramColumnValues returns the values of the column/expression `col` of all matched jobs by
+// the ID of their group (empty without `groupBy`). NULL values are skipped.
+func (r *queryResolver) histogramColumnValues(
+	ctx context.Context,
+	filter []*model.JobFilter,
+	col string,
+	groupBy *model.Aggregate) (map[string][]float64, error) {
+
+	groupCol := "''"
+	if groupBy != nil {
+		groupCol = repository.AggregateColumn(*groupBy)
+	}
+
+	query := sq.Select(groupCol, col).From("job").Where(col + " IS NOT NULL")
+	if groupBy != nil {
+		query = repository.JoinAggregate(query, *groupBy)
+	}
+	query = repository.SecurityCheck(ctx, query)
+	for _, f := range filter {
+		query = repository.BuildWhereClause(f, query)
+	}
+
+	rows, err := query.RunWith(r.DB).Query()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := map[string][]float64{}
+	for rows.Next() {
+		var id sql.NullString
+		var value sql.NullFloat64
+		if err := rows.Scan(&id, &value); err != nil {
+			return nil, err
+		}
+
+		if id.Valid && value.Valid {
+			values[id.String] = append(values[id.String], value.Float64)
+		}
+	}
+	return values, rows.Err()
+}
+
+// histogramMetricValues returns the `statistic` of `metric` of all matched jobs by the ID of their group
+// (empty without `groupBy`). The average is the footprint (see metricdata.LoadAverages), the minimum and
+// maximum are loaded by metricdata.LoadStatistics. Jobs without data are skipped.
+func (r *queryResolver) histogramMetricValues(
+	ctx context.Context,
+	filter []*model.JobFilter,
+	metric string,
+	statistic model.MetricStatistic,
+	groupBy *model.Aggregate) (map[string][]float64, error) {
+
+	jobs, err := r.Repo.QueryJobs(ctx, filter, &model.PageRequest{Page: 1, ItemsPerPage: MAX_JOBS_FOR_ANALYSIS + 1}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(jobs) > MAX_JOBS_FOR_ANALYSIS {
+		return nil, fmt.Errorf("too many jobs matched (max: %d)", MAX_JOBS_FOR_ANALYSIS)
+	}
+
+	values := map[string][]float64{}
+	for _, job := range jobs {
+		if job.MonitoringStatus == schema.MonitoringStatusDisabled || job.MonitoringStatus == schema.MonitoringStatusArchivingFailed {
+			continue
+		}
+
+		value, err := loadMetricStatistic(ctx, job, metric, statistic)
+		if err != nil {
+			return nil, err
+		}
+		if math.IsNaN(value) {
+			continue
+		}
+
+		ids := []string{""}
+		if groupBy != nil {
+			switch *groupBy {
+			case model.AggregateUser:
+				ids[0] = job.User
+			case model.AggregateProject:
+				ids[0] = job.Project
+			case model.AggregateCluster:
+				ids[0] = job.Cluster
+			case model.AggregateSubcluster:
+				ids[0] = job.SubCluster
+			case model.AggregatePartition:
+				ids[0] = job.Partition
+			case model.AggregateTag:
+				tags, err := r.Repo.GetTags(&job.ID)
+				if err != nil {
+					return nil, err
+				}
+				ids = ids[:0]
+				for _, tag := range tags {
+					ids = append(ids, strconv.FormatInt(tag.ID, 10))
+				}
+			}
+		}
+		for _, id := range ids {
+			values[id] = append(values[id], value)
+		}
+	}
+	return values, nil
+}
+
+// loadMetricStatistic returns the `statistic` of `metric` of a job (NaN if there is no data).
+func loadMetricStatistic(ctx context.Context, job *schema.Job, metric string, statistic model.MetricStatistic) (float64, error) {
+	if statistic == model.MetricStatisticAvg {
+		data := [][]schema.Float{make([]schema.Float, 0, 1)}
+		if err := metricdata.LoadAverages(job, []string{metric}, data, ctx); err != nil {
+			return math.NaN(), err
+		}
+		if len(data[0]) == 0 {
+			return math.NaN(), nil
+		}
+		return float64(data[0][0]), nil
+	}
+
+	stats, err := metricdata.LoadStatistics(job, metric, ctx)
+	if err != nil || stats == nil {
+		return math.NaN(), err
+	}
+	if statistic == model.MetricStatisticMin {
+		return stats.Min, nil
+	}
+	return stats.Max, nil
+}
+
+// footprintStatistic returns the statistic stored in a footprint column of the job table.
+func footprintStatistic(col string) model.MetricStatistic {
+	if strings.HasSuffix(col, "_max") {
+		return model.MetricStatisticMax
+	}
+	return model.MetricStatisticAvg
+}
+
+// isConfiguredMetric returns true if any cluster has a metric of that name.
+func isConfiguredMetric(metric string) bool {
+	for _, cluster := range archive.Clusters {
+		for _, mc := range cluster.MetricConfig {
+			if mc.Name == metric {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// histogramBins counts the sorted `values` in the bins described by `spec`.
+func histogramBins(values []float64, spec *model.HistogramBins) ([]*model.HistogramBin, error) {
+	logScale := spec.Log != nil && *spec.Log
+	transform, inverse := func(x float64) float64 { return x }, func(x float64) float64 { return x }
+	if logScale {
+		transform, inverse = math.Log10, func(x float64) float64 { return math.Pow(10, x) }
+		if (spec.Min != nil && *spec.Min <= 0) || (spec.Max != nil && *spec.Max <= 0) {
+			return nil, errors.New("the range of a histogram with a log scale has to be positive")
+		}
+
+		// Only positive values can be shown on a log scale:
+		first := sort.SearchFloat64s(values, math.SmallestNonzeroFloat64)
+		values = values[first:]
+	}
+
+	if len(values) == 0 && (spec.Min == nil || spec.Max == nil) {
+		return []*model.HistogramBin{}, nil
+	}
+
+	min, max := 0.0, 0.0
+	if len(values) > 0 {
+		min, max = values[0], values[len(values)-1]
+	}
+	if spec.Min != nil {
+		min = *spec.Min
+	}
+	if spec.Max != nil {
+		max = *spec.Max
+	}
+	if min > max {
+		return nil, errors.New("the minimum of a histogram has to be less than the maximum")
+	}
+	min, max = transform(min), transform(max)
+
+	var count int
+	var start, width float64
+	if spec.Width != nil {
+		if width = *spec.Width; width <= 0 {
+			return nil, errors.New("the width of histogram bins has to be positive")
+		}
+		start = math.Floor(min/width) * width
+		if n := math.Floor((max-start)/width) + 1; n > float64(maxHistogramBins) {
+			return nil, fmt.Errorf("too many histogram bins (max: %d)", maxHistogramBins)
+		} else {
+			count = int(n)
+		}
+	} else {
+		count = defaultHistogramBins
+		if spec.Count != nil {
+			count = *spec.Count
+		}
+		if count <= 0 || count > maxHistogramBins {
+			return nil, fmt.Errorf("the number of histogram bins has to be between 1 and %d", maxHistogramBins)
+		}
+		if max == min {
+			count = 1
+		}
+		start, width = min, (max-min)/float64(count)
+	}
+
+	bins := make([]*model.HistogramBin, count)
+	for i := range bins {
+		bins[i] = &model.HistogramBin{
+			From: inverse(start + float64(i)*width),
+			To:   inverse(start + float64(i+1)*width),
+		}
+	}
+
+	for _, value := range values {
+		x := transform(value)
+		if x < min || x > max {
+			continue
+		}
+
+		i := 0
+		if width > 0 {
+			i = int(math.Floor((x - start) / width))
+		}
+		if i >= count {
+			// The upper bound of the last bin is inclusive.
+			i = count - 1
+		}
+		bins[i].Count += 1
+	}
+
+	return bins, nil
+}
+
+// percentile interpolates linearly between the closest ranks of the sorted `values`.
+func percentile(values []float64, p float64) float64 {
+	pos := p / 100 * float64(len(values)-1)
+	lower := int(math.Floor(pos))
+	if lower >= len(values)-1 {
+		return values[len(values)-1]
+	}
+	return values[lower] + (pos-float64(lower))*(values[lower+1]-values[lower])
+}
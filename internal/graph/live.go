@@ -0,0 +1,78 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/internal/metricdata"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+// How often a subscription checks if the job is still running.
+const jobStateCheckInterval = time.Minute
+
+// jobMetricUpdates streams the new data points of a running job. The subscription ends when
+// the job is not running anymore.
+func (r *subscriptionResolver) jobMetricUpdates(
+	ctx context.Context,
+	id string,
+	metrics []string,
+	scopes []schema.MetricScope) (<-chan []*metricdata.LiveUpdate, error) {
+
+	numericId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := r.Repo.FindByIdWithUser(ctx, numericId)
+	if err == sql.ErrNoRows {
+		return nil, errors.New("you are not allowed to see this job")
+	} else if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	updates, err := metricdata.SubscribeJob(ctx, job, metrics, scopes)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	res := make(chan []*metricdata.LiveUpdate, 1)
+	go func() {
+		defer close(res)
+		defer cancel()
+
+		ticker := time.NewTicker(jobStateCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case update, ok := <-updates:
+				if !ok {
+					return
+				}
+				select {
+				case res <- update:
+				case <-ctx.Done():
+					return
+				}
+			case <-ticker.C:
+				job, err := r.Repo.FindById(job.ID)
+				if err != nil || job.State != schema.JobStateRunning {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return res, nil
+}
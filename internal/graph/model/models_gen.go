@@ -11,6 +11,41 @@ import (
 	"github.com/ClusterCockpit/cc-backend/pkg/schema"
 )
 
+type ArrayJob struct {
+	Cluster        string                   `json:"cluster"`
+	ArrayJobID     int                      `json:"arrayJobId"`
+	NumJobs        int                      `json:"numJobs"`
+	StartTime      time.Time                `json:"startTime"`
+	States         []*Count                 `json:"states"`
+	TotalWalltime  int                      `json:"totalWalltime"`
+	TotalCoreHours int                      `json:"totalCoreHours"`
+	Duration       *schema.MetricStatistics `json:"duration"`
+	HistDuration   []*HistoPoint            `json:"histDuration"`
+	Footprints     []*ArrayJobFootprint     `json:"footprints"`
+	Jobs           []*schema.Job            `json:"jobs"`
+}
+
+type ArrayJobFootprint struct {
+	Metric string                   `json:"metric"`
+	Stats  *schema.MetricStatistics `json:"stats"`
+}
+
+type ClusterUtilization struct {
+	From              time.Time            `json:"from"`
+	BucketSize        int                  `json:"bucketSize"`
+	TotalNodes        int                  `json:"totalNodes"`
+	TotalCores        int                  `json:"totalCores"`
+	TotalAccelerators int                  `json:"totalAccelerators"`
+	Series            []*UtilizationSeries `json:"series"`
+}
+
+type ComparedSeries struct {
+	Job      string         `json:"job"`
+	Hostname *string        `json:"hostname"`
+	Timestep int            `json:"timestep"`
+	Data     []schema.Float `json:"data"`
+}
+
 type Count struct {
 	Name  string `json:"name"`
 	Count int    `json:"count"`
@@ -31,11 +66,37 @@ type HistoPoint struct {
 	Value int `json:"value"`
 }
 
+type Histogram struct {
+	ID          string          `json:"id"`
+	Count       int             `json:"count"`
+	Bins        []*HistogramBin `json:"bins"`
+	Percentiles []*Percentile   `json:"percentiles"`
+}
+
+type HistogramBin struct {
+	From  float64 `json:"from"`
+	To    float64 `json:"to"`
+	Count int     `json:"count"`
+}
+
+type HistogramBins struct {
+	Count *int     `json:"count"`
+	Width *float64 `json:"width"`
+	Log   *bool    `json:"log"`
+	Min   *float64 `json:"min"`
+	Max   *float64 `json:"max"`
+}
+
 type IntRangeOutput struct {
 	From int `json:"from"`
 	To   int `json:"to"`
 }
 
+type JobComparison struct {
+	Jobs    []*schema.Job       `json:"jobs"`
+	Metrics []*MetricComparison `json:"metrics"`
+}
+
 type JobFilter struct {
 	Tags            []string          `json:"tags"`
 	JobID           *StringInput      `json:"jobId"`
@@ -55,6 +116,7 @@ type JobFilter struct {
 	MemBwAvg        *FloatRange       `json:"memBwAvg"`
 	LoadAvg         *FloatRange       `json:"loadAvg"`
 	MemUsedMax      *FloatRange       `json:"memUsedMax"`
+	GroupByArray    *bool             `json:"groupByArray"`
 }
 
 type JobMetricWithName struct {
@@ -63,14 +125,19 @@ type JobMetricWithName struct {
 }
 
 type JobResultList struct {
-	Items  []*schema.Job `json:"items"`
-	Offset *int          `json:"offset"`
-	Limit  *int          `json:"limit"`
-	Count  *int          `json:"count"`
+	Items           []*schema.Job `json:"items"`
+	Offset          *int          `json:"offset"`
+	Limit           *int          `json:"limit"`
+	Count           *int          `json:"count"`
+	StartCursor     *string       `json:"startCursor"`
+	EndCursor       *string       `json:"endCursor"`
+	HasNextPage     *bool         `json:"hasNextPage"`
+	HasPreviousPage *bool         `json:"hasPreviousPage"`
 }
 
 type JobsStatistics struct {
 	ID             string        `json:"id"`
+	Bucket         *time.Time    `json:"bucket"`
 	TotalJobs      int           `json:"totalJobs"`
 	ShortJobs      int           `json:"shortJobs"`
 	TotalWalltime  int           `json:"totalWalltime"`
@@ -79,11 +146,26 @@ type JobsStatistics struct {
 	HistNumNodes   []*HistoPoint `json:"histNumNodes"`
 }
 
+type MetricComparison struct {
+	Name         string                  `json:"name"`
+	Unit         string                  `json:"unit"`
+	Statistics   []*schema.JobStatistics `json:"statistics"`
+	RelativeDiff []schema.Float          `json:"relativeDiff"`
+	Series       []*ComparedSeries       `json:"series"`
+}
+
 type MetricFootprints struct {
 	Metric string         `json:"metric"`
 	Data   []schema.Float `json:"data"`
 }
 
+type NodeFilter struct {
+	Hostname   *StringInput       `json:"hostname"`
+	Cluster    *StringInput       `json:"cluster"`
+	SubCluster *StringInput       `json:"subCluster"`
+	NodeState  []schema.NodeState `json:"nodeState"`
+}
+
 type NodeMetrics struct {
 	Host       string               `json:"host"`
 	SubCluster string               `json:"subCluster"`
@@ -91,8 +173,10 @@ type NodeMetrics struct {
 }
 
 type OrderByInput struct {
-	Field string            `json:"field"`
-	Order SortDirectionEnum `json:"order"`
+	Field   SortByAttribute   `json:"field"`
+	MetaKey *string           `json:"metaKey"`
+	Order   SortDirectionEnum `json:"order"`
+	Nulls   *NullsOrder       `json:"nulls"`
 }
 
 type PageRequest struct {
@@ -100,6 +184,11 @@ type PageRequest struct {
 	Page         int `json:"page"`
 }
 
+type Percentile struct {
+	Percentile float64 `json:"percentile"`
+	Value      float64 `json:"value"`
+}
+
 type StringInput struct {
 	Eq         *string `json:"eq"`
 	Contains   *string `json:"contains"`
@@ -107,6 +196,11 @@ type StringInput struct {
 	EndsWith   *string `json:"endsWith"`
 }
 
+type TimeBucket struct {
+	Size     TimeBucketSize `json:"size"`
+	TimeZone *string        `json:"timeZone"`
+}
+
 type TimeRangeOutput struct {
 	From time.Time `json:"from"`
 	To   time.Time `json:"to"`
@@ -118,23 +212,36 @@ type User struct {
 	Email    string `json:"email"`
 }
 
+type UtilizationSeries struct {
+	ID           string    `json:"id"`
+	Nodes        []float64 `json:"nodes"`
+	Cores        []float64 `json:"cores"`
+	Accelerators []float64 `json:"accelerators"`
+}
+
 type Aggregate string
 
 const (
-	AggregateUser    Aggregate = "USER"
-	AggregateProject Aggregate = "PROJECT"
-	AggregateCluster Aggregate = "CLUSTER"
+	AggregateUser       Aggregate = "USER"
+	AggregateProject    Aggregate = "PROJECT"
+	AggregateCluster    Aggregate = "CLUSTER"
+	AggregateSubcluster Aggregate = "SUBCLUSTER"
+	AggregatePartition  Aggregate = "PARTITION"
+	AggregateTag        Aggregate = "TAG"
 )
 
 var AllAggregate = []Aggregate{
 	AggregateUser,
 	AggregateProject,
 	AggregateCluster,
+	AggregateSubcluster,
+	AggregatePartition,
+	AggregateTag,
 }
 
 func (e Aggregate) IsValid() bool {
 	switch e {
-	case AggregateUser, AggregateProject, AggregateCluster:
+	case AggregateUser, AggregateProject, AggregateCluster, AggregateSubcluster, AggregatePartition, AggregateTag:
 		return true
 	}
 	return false
@@ -161,6 +268,181 @@ func (e Aggregate) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type MetricStatistic string
+
+const (
+	MetricStatisticMin MetricStatistic = "MIN"
+	MetricStatisticAvg MetricStatistic = "AVG"
+	MetricStatisticMax MetricStatistic = "MAX"
+)
+
+var AllMetricStatistic = []MetricStatistic{
+	MetricStatisticMin,
+	MetricStatisticAvg,
+	MetricStatisticMax,
+}
+
+func (e MetricStatistic) IsValid() bool {
+	switch e {
+	case MetricStatisticMin, MetricStatisticAvg, MetricStatisticMax:
+		return true
+	}
+	return false
+}
+
+func (e MetricStatistic) String() string {
+	return string(e)
+}
+
+func (e *MetricStatistic) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MetricStatistic(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MetricStatistic", str)
+	}
+	return nil
+}
+
+func (e MetricStatistic) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type NullsOrder string
+
+const (
+	NullsOrderFirst NullsOrder = "FIRST"
+	NullsOrderLast  NullsOrder = "LAST"
+)
+
+var AllNullsOrder = []NullsOrder{
+	NullsOrderFirst,
+	NullsOrderLast,
+}
+
+func (e NullsOrder) IsValid() bool {
+	switch e {
+	case NullsOrderFirst, NullsOrderLast:
+		return true
+	}
+	return false
+}
+
+func (e NullsOrder) String() string {
+	return string(e)
+}
+
+func (e *NullsOrder) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NullsOrder(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NullsOrder", str)
+	}
+	return nil
+}
+
+func (e NullsOrder) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SortByAttribute string
+
+const (
+	SortByAttributeID               SortByAttribute = "id"
+	SortByAttributeJobID            SortByAttribute = "jobId"
+	SortByAttributeUser             SortByAttribute = "user"
+	SortByAttributeProject          SortByAttribute = "project"
+	SortByAttributeCluster          SortByAttribute = "cluster"
+	SortByAttributeSubCluster       SortByAttribute = "subCluster"
+	SortByAttributePartition        SortByAttribute = "partition"
+	SortByAttributeArrayJobID       SortByAttribute = "arrayJobId"
+	SortByAttributeStartTime        SortByAttribute = "startTime"
+	SortByAttributeDuration         SortByAttribute = "duration"
+	SortByAttributeWalltime         SortByAttribute = "walltime"
+	SortByAttributeNumNodes         SortByAttribute = "numNodes"
+	SortByAttributeNumHWThreads     SortByAttribute = "numHWThreads"
+	SortByAttributeNumAcc           SortByAttribute = "numAcc"
+	SortByAttributeExclusive        SortByAttribute = "exclusive"
+	SortByAttributeMonitoringStatus SortByAttribute = "monitoringStatus"
+	SortByAttributeSmt              SortByAttribute = "SMT"
+	SortByAttributeState            SortByAttribute = "state"
+	SortByAttributeFlopsAnyAvg      SortByAttribute = "flopsAnyAvg"
+	SortByAttributeMemBwAvg         SortByAttribute = "memBwAvg"
+	SortByAttributeLoadAvg          SortByAttribute = "loadAvg"
+	SortByAttributeMemUsedMax       SortByAttribute = "memUsedMax"
+	SortByAttributeNetBwAvg         SortByAttribute = "netBwAvg"
+	SortByAttributeNetDataVolTotal  SortByAttribute = "netDataVolTotal"
+	SortByAttributeFileBwAvg        SortByAttribute = "fileBwAvg"
+	SortByAttributeFileDataVolTotal SortByAttribute = "fileDataVolTotal"
+	SortByAttributeMetaData         SortByAttribute = "metaData"
+)
+
+var AllSortByAttribute = []SortByAttribute{
+	SortByAttributeID,
+	SortByAttributeJobID,
+	SortByAttributeUser,
+	SortByAttributeProject,
+	SortByAttributeCluster,
+	SortByAttributeSubCluster,
+	SortByAttributePartition,
+	SortByAttributeArrayJobID,
+	SortByAttributeStartTime,
+	SortByAttributeDuration,
+	SortByAttributeWalltime,
+	SortByAttributeNumNodes,
+	SortByAttributeNumHWThreads,
+	SortByAttributeNumAcc,
+	SortByAttributeExclusive,
+	SortByAttributeMonitoringStatus,
+	SortByAttributeSmt,
+	SortByAttributeState,
+	SortByAttributeFlopsAnyAvg,
+	SortByAttributeMemBwAvg,
+	SortByAttributeLoadAvg,
+	SortByAttributeMemUsedMax,
+	SortByAttributeNetBwAvg,
+	SortByAttributeNetDataVolTotal,
+	SortByAttributeFileBwAvg,
+	SortByAttributeFileDataVolTotal,
+	SortByAttributeMetaData,
+}
+
+func (e SortByAttribute) IsValid() bool {
+	switch e {
+	case SortByAttributeID, SortByAttributeJobID, SortByAttributeUser, SortByAttributeProject, SortByAttributeCluster, SortByAttributeSubCluster, SortByAttributePartition, SortByAttributeArrayJobID, SortByAttributeStartTime, SortByAttributeDuration, SortByAttributeWalltime, SortByAttributeNumNodes, SortByAttributeNumHWThreads, SortByAttributeNumAcc, SortByAttributeExclusive, SortByAttributeMonitoringStatus, SortByAttributeSmt, SortByAttributeState, SortByAttributeFlopsAnyAvg, SortByAttributeMemBwAvg, SortByAttributeLoadAvg, SortByAttributeMemUsedMax, SortByAttributeNetBwAvg, SortByAttributeNetDataVolTotal, SortByAttributeFileBwAvg, SortByAttributeFileDataVolTotal, SortByAttributeMetaData:
+		return true
+	}
+	return false
+}
+
+func (e SortByAttribute) String() string {
+	return string(e)
+}
+
+func (e *SortByAttribute) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortByAttribute(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortByAttribute", str)
+	}
+	return nil
+}
+
+func (e SortByAttribute) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type SortDirectionEnum string
 
 const (
@@ -202,6 +484,49 @@ func (e SortDirectionEnum) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type TimeBucketSize string
+
+const (
+	TimeBucketSizeDay   TimeBucketSize = "DAY"
+	TimeBucketSizeWeek  TimeBucketSize = "WEEK"
+	TimeBucketSizeMonth TimeBucketSize = "MONTH"
+)
+
+var AllTimeBucketSize = []TimeBucketSize{
+	TimeBucketSizeDay,
+	TimeBucketSizeWeek,
+	TimeBucketSizeMonth,
+}
+
+func (e TimeBucketSize) IsValid() bool {
+	switch e {
+	case TimeBucketSizeDay, TimeBucketSizeWeek, TimeBucketSizeMonth:
+		return true
+	}
+	return false
+}
+
+func (e TimeBucketSize) String() string {
+	return string(e)
+}
+
+func (e *TimeBucketSize) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TimeBucketSize(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TimeBucketSize", str)
+	}
+	return nil
+}
+
+func (e TimeBucketSize) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Weights string
 
 const (
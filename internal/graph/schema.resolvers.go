@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -19,6 +20,24 @@ import (
 	"github.com/ClusterCockpit/cc-backend/pkg/schema"
 )
 
+// Jobs is the resolver for the jobs field.
+func (r *arrayJobResolver) Jobs(ctx context.Context, obj *model.ArrayJob, page *model.PageRequest) ([]*schema.Job, error) {
+	cluster, arrayJobId := obj.Cluster, obj.ArrayJobID
+	filter := []*model.JobFilter{{
+		Cluster:    &model.StringInput{Eq: &cluster},
+		ArrayJobID: &arrayJobId,
+	}}
+
+	if page == nil {
+		page = &model.PageRequest{
+			ItemsPerPage: 50,
+			Page:         1,
+		}
+	}
+
+	return r.Repo.QueryJobs(ctx, filter, page, []*model.OrderByInput{{Field: model.SortByAttributeJobID, Order: model.SortDirectionEnumAsc}})
+}
+
 // Partitions is the resolver for the partitions field.
 func (r *clusterResolver) Partitions(ctx context.Context, obj *schema.Cluster) ([]string, error) {
 	return r.Repo.Partitions(obj.Name)
@@ -107,6 +126,11 @@ func (r *mutationResolver) UpdateConfiguration(ctx context.Context, name string,
 	return nil, nil
 }
 
+// StateHistory is the resolver for the stateHistory field.
+func (r *nodeResolver) StateHistory(ctx context.Context, obj *schema.Node, from *time.Time, to *time.Time) ([]*schema.NodeStateChange, error) {
+	return repository.GetNodeRepository().NodeStateHistory(obj.ID, from, to)
+}
+
 // Clusters is the resolver for the clusters field.
 func (r *queryResolver) Clusters(ctx context.Context) ([]*schema.Cluster, error) {
 	return archive.Clusters, nil
@@ -140,6 +164,11 @@ func (r *queryResolver) AllocatedNodes(ctx context.Context, cluster string) ([]*
 	return counts, nil
 }
 
+// ClusterUtilization is the resolver for the clusterUtilization field.
+func (r *queryResolver) ClusterUtilization(ctx context.Context, cluster string, subCluster *string, from time.Time, to time.Time, bucketSize int, groupBy *model.Aggregate) (*model.ClusterUtilization, error) {
+	return r.clusterUtilization(ctx, cluster, subCluster, from, to, bucketSize, groupBy)
+}
+
 // Job is the resolver for the job field.
 func (r *queryResolver) Job(ctx context.Context, id string) (*schema.Job, error) {
 	numericId, err := strconv.ParseInt(id, 10, 64)
@@ -159,14 +188,19 @@ func (r *queryResolver) Job(ctx context.Context, id string) (*schema.Job, error)
 	return job, nil
 }
 
+// ArrayJob is the resolver for the arrayJob field.
+func (r *queryResolver) ArrayJob(ctx context.Context, cluster string, arrayJobID int) (*model.ArrayJob, error) {
+	return r.arrayJob(ctx, cluster, arrayJobID)
+}
+
 // JobMetrics is the resolver for the jobMetrics field.
-func (r *queryResolver) JobMetrics(ctx context.Context, id string, metrics []string, scopes []schema.MetricScope) ([]*model.JobMetricWithName, error) {
+func (r *queryResolver) JobMetrics(ctx context.Context, id string, metrics []string, scopes []schema.MetricScope, resolution *int) ([]*model.JobMetricWithName, error) {
 	job, err := r.Query().Job(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := metricdata.LoadData(job, metrics, scopes, ctx)
+	data, err := metricdata.LoadData(job, metrics, scopes, ctx, maxPoints(resolution))
 	if err != nil {
 		return nil, err
 	}
@@ -193,31 +227,62 @@ func (r *queryResolver) JobsFootprints(ctx context.Context, filter []*model.JobF
 	return r.jobsFootprints(ctx, filter, metrics)
 }
 
+// CompareJobs is the resolver for the compareJobs field.
+func (r *queryResolver) CompareJobs(ctx context.Context, ids []string, metrics []string, averageNodes *bool, resolution *int) (*model.JobComparison, error) {
+	return r.compareJobs(ctx, ids, metrics, averageNodes != nil && *averageNodes, maxPoints(resolution))
+}
+
 // Jobs is the resolver for the jobs field.
-func (r *queryResolver) Jobs(ctx context.Context, filter []*model.JobFilter, page *model.PageRequest, order *model.OrderByInput) (*model.JobResultList, error) {
-	if page == nil {
-		page = &model.PageRequest{
-			ItemsPerPage: 50,
-			Page:         1,
+func (r *queryResolver) Jobs(ctx context.Context, filter []*model.JobFilter, page *model.PageRequest, order []*model.OrderByInput, after *string, before *string) (*model.JobResultList, error) {
+	count, err := r.Repo.CountJobs(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keyset pagination is only used if a cursor is passed.
+	if after == nil && before == nil {
+		if page == nil {
+			page = &model.PageRequest{
+				ItemsPerPage: 50,
+				Page:         1,
+			}
 		}
+
+		jobs, err := r.Repo.QueryJobs(ctx, filter, page, order)
+		if err != nil {
+			return nil, err
+		}
+
+		return &model.JobResultList{Items: jobs, Count: &count}, nil
 	}
 
-	jobs, err := r.Repo.QueryJobs(ctx, filter, page, order)
-	if err != nil {
-		return nil, err
+	limit := 50
+	if page != nil {
+		limit = page.ItemsPerPage
 	}
 
-	count, err := r.Repo.CountJobs(ctx, filter)
+	res, err := r.Repo.QueryJobsKeyset(ctx, filter, limit, order, after, before)
 	if err != nil {
 		return nil, err
 	}
 
-	return &model.JobResultList{Items: jobs, Count: &count}, nil
+	list := &model.JobResultList{
+		Items:           res.Jobs,
+		Count:           &count,
+		Limit:           &limit,
+		HasNextPage:     &res.HasNextPage,
+		HasPreviousPage: &res.HasPreviousPage,
+	}
+	if len(res.Jobs) > 0 {
+		list.StartCursor, list.EndCursor = &res.StartCursor, &res.EndCursor
+	}
+
+	return list, nil
 }
 
 // JobsStatistics is the resolver for the jobsStatistics field.
-func (r *queryResolver) JobsStatistics(ctx context.Context, filter []*model.JobFilter, groupBy *model.Aggregate) ([]*model.JobsStatistics, error) {
-	return r.jobsStatistics(ctx, filter, groupBy)
+func (r *queryResolver) JobsStatistics(ctx context.Context, filter []*model.JobFilter, groupBy *model.Aggregate, timeBucket *model.TimeBucket) ([]*model.JobsStatistics, error) {
+	return r.jobsStatistics(ctx, filter, groupBy, timeBucket)
 }
 
 // JobsCount is the resolver for the jobsCount field.
@@ -237,13 +302,18 @@ func (r *queryResolver) JobsCount(ctx context.Context, filter []*model.JobFilter
 	return res, nil
 }
 
+// JobsHistogram is the resolver for the jobsHistogram field.
+func (r *queryResolver) JobsHistogram(ctx context.Context, filter []*model.JobFilter, value string, statistic *model.MetricStatistic, bins *model.HistogramBins, percentiles []float64, groupBy *model.Aggregate) ([]*model.Histogram, error) {
+	return r.jobsHistogram(ctx, filter, value, statistic, bins, percentiles, groupBy)
+}
+
 // RooflineHeatmap is the resolver for the rooflineHeatmap field.
 func (r *queryResolver) RooflineHeatmap(ctx context.Context, filter []*model.JobFilter, rows int, cols int, minX float64, minY float64, maxX float64, maxY float64) ([][]float64, error) {
 	return r.rooflineHeatmap(ctx, filter, rows, cols, minX, minY, maxX, maxY)
 }
 
 // NodeMetrics is the resolver for the nodeMetrics field.
-func (r *queryResolver) NodeMetrics(ctx context.Context, cluster string, nodes []string, scopes []schema.MetricScope, metrics []string, from time.Time, to time.Time) ([]*model.NodeMetrics, error) {
+func (r *queryResolver) NodeMetrics(ctx context.Context, cluster string, nodes []string, scopes []schema.MetricScope, metrics []string, from time.Time, to time.Time, resolution *int) ([]*model.NodeMetrics, error) {
 	user := auth.GetUser(ctx)
 	if user != nil && !user.HasRole(auth.RoleAdmin) {
 		return nil, errors.New("you need to be an administrator for this query")
@@ -255,7 +325,7 @@ func (r *queryResolver) NodeMetrics(ctx context.Context, cluster string, nodes [
 		}
 	}
 
-	data, err := metricdata.LoadNodeData(cluster, metrics, nodes, scopes, from, to, ctx)
+	data, err := metricdata.LoadNodeData(cluster, metrics, nodes, scopes, from, to, ctx, maxPoints(resolution))
 	if err != nil {
 		return nil, err
 	}
@@ -283,6 +353,48 @@ func (r *queryResolver) NodeMetrics(ctx context.Context, cluster string, nodes [
 	return nodeMetrics, nil
 }
 
+// Nodes is the resolver for the nodes field.
+func (r *queryResolver) Nodes(ctx context.Context, filter []*model.NodeFilter) ([]*schema.Node, error) {
+	user := auth.GetUser(ctx)
+	if user != nil && !user.HasRole(auth.RoleAdmin) {
+		return nil, errors.New("you need to be an administrator for this query")
+	}
+
+	return repository.GetNodeRepository().QueryNodes(filter)
+}
+
+// NodeStates is the resolver for the nodeStates field.
+func (r *queryResolver) NodeStates(ctx context.Context, filter []*model.NodeFilter) ([]*model.Count, error) {
+	user := auth.GetUser(ctx)
+	if user != nil && !user.HasRole(auth.RoleAdmin) {
+		return nil, errors.New("you need to be an administrator for this query")
+	}
+
+	counts, err := repository.GetNodeRepository().CountNodeStates(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*model.Count, 0, len(counts))
+	for state, count := range counts {
+		res = append(res, &model.Count{
+			Name:  state,
+			Count: count,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+
+	return res, nil
+}
+
+// JobMetricUpdates is the resolver for the jobMetricUpdates field.
+func (r *subscriptionResolver) JobMetricUpdates(ctx context.Context, id string, metrics []string, scopes []schema.MetricScope) (<-chan []*metricdata.LiveUpdate, error) {
+	return r.jobMetricUpdates(ctx, id, metrics, scopes)
+}
+
+// ArrayJob returns generated.ArrayJobResolver implementation.
+func (r *Resolver) ArrayJob() generated.ArrayJobResolver { return &arrayJobResolver{r} }
+
 // Cluster returns generated.ClusterResolver implementation.
 func (r *Resolver) Cluster() generated.ClusterResolver { return &clusterResolver{r} }
 
@@ -292,10 +404,19 @@ func (r *Resolver) Job() generated.JobResolver { return &jobResolver{r} }
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
+// Node returns generated.NodeResolver implementation.
+func (r *Resolver) Node() generated.NodeResolver { return &nodeResolver{r} }
+
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type arrayJobResolver struct{ *Resolver }
 type clusterResolver struct{ *Resolver }
 type jobResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
+type nodeResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
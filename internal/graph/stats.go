@@ -10,9 +10,13 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/ClusterCockpit/cc-backend/internal/config"
 	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
 	"github.com/ClusterCockpit/cc-backend/internal/metricdata"
 	"github.com/ClusterCockpit/cc-backend/internal/repository"
@@ -21,51 +25,74 @@ import (
 	sq "github.com/Masterminds/squirrel"
 )
 
-// GraphQL validation should make sure that no unkown values can be specified.
-var groupBy2column = map[model.Aggregate]string{
-	model.AggregateUser:    "job.user",
-	model.AggregateProject: "job.project",
-	model.AggregateCluster: "job.cluster",
+// ShortJobDuration returns the duration in seconds below which jobs count as short.
+func ShortJobDuration() int {
+	return config.Keys.ShortJobDuration
 }
 
-const ShortJobDuration int = 5 * 60
+// The key of a model.JobsStatistics: the group (empty if `groupBy` is not used) and the start of the time bucket (0 if not used).
+type statsKey struct {
+	id     string
+	bucket int64
+}
 
 // Helper function for the jobsStatistics GraphQL query placed here so that schema.resolvers.go is not too full.
-func (r *queryResolver) jobsStatistics(ctx context.Context, filter []*model.JobFilter, groupBy *model.Aggregate) ([]*model.JobsStatistics, error) {
-	// In case `groupBy` is nil (not used), the model.JobsStatistics used is at the key '' (empty string)
-	stats := map[string]*model.JobsStatistics{}
+func (r *queryResolver) jobsStatistics(
+	ctx context.Context,
+	filter []*model.JobFilter,
+	groupBy *model.Aggregate,
+	timeBucket *model.TimeBucket) ([]*model.JobsStatistics, error) {
+
+	stats := map[statsKey]*model.JobsStatistics{}
+
+	var buckets *timeBuckets
+	if timeBucket != nil {
+		var err error
+		if buckets, err = r.timeBuckets(ctx, filter, timeBucket); err != nil {
+			return nil, err
+		}
+		if buckets == nil {
+			return []*model.JobsStatistics{}, nil
+		}
+	}
+
+	// The group and the start of the time bucket are always the first two columns.
+	selectStats := func(columns ...string) sq.SelectBuilder {
+		groupCol, bucketCol := "''", "0 AS bucket"
+		if groupBy != nil {
+			groupCol = repository.AggregateColumn(*groupBy)
+		}
+		if buckets != nil {
+			bucketCol = buckets.column() + " AS bucket"
+		}
+
+		query := sq.Select(append([]string{groupCol, bucketCol}, columns...)...).From("job")
+		if groupBy != nil {
+			query = repository.JoinAggregate(query, *groupBy).GroupBy(groupCol)
+		}
+		if buckets != nil {
+			query = query.GroupBy("bucket")
+		}
+
+		query = repository.SecurityCheck(ctx, query)
+		for _, f := range filter {
+			query = repository.BuildWhereClause(f, query)
+		}
+		return query
+	}
 
 	// `socketsPerNode` and `coresPerSocket` can differ from cluster to cluster, so we need to explicitly loop over those.
 	for _, cluster := range archive.Clusters {
 		for _, subcluster := range cluster.SubClusters {
 			corehoursCol := fmt.Sprintf("CAST(ROUND(SUM(job.duration * job.num_nodes * %d * %d) / 3600) as int)", subcluster.SocketsPerNode, subcluster.CoresPerSocket)
-			var query sq.SelectBuilder
-			if groupBy == nil {
-				query = sq.Select(
-					"''",
-					"COUNT(job.id)",
-					"CAST(ROUND(SUM(job.duration) / 3600) as int)",
-					corehoursCol,
-				).From("job")
-			} else {
-				col := groupBy2column[*groupBy]
-				query = sq.Select(
-					col,
-					"COUNT(job.id)",
-					"CAST(ROUND(SUM(job.duration) / 3600) as int)",
-					corehoursCol,
-				).From("job").GroupBy(col)
-			}
-
-			query = query.
+			query := selectStats(
+				"COUNT(job.id)",
+				"CAST(ROUND(SUM(job.duration) / 3600) as int)",
+				corehoursCol,
+			).
 				Where("job.cluster = ?", cluster.Name).
 				Where("job.subcluster = ?", subcluster.Name)
 
-			query = repository.SecurityCheck(ctx, query)
-			for _, f := range filter {
-				query = repository.BuildWhereClause(f, query)
-			}
-
 			rows, err := query.RunWith(r.DB).Query()
 			if err != nil {
 				return nil, err
@@ -73,62 +100,56 @@ func (r *queryResolver) jobsStatistics(ctx context.Context, filter []*model.JobF
 
 			for rows.Next() {
 				var id sql.NullString
+				var bucket int64
 				var jobs, walltime, corehours sql.NullInt64
-				if err := rows.Scan(&id, &jobs, &walltime, &corehours); err != nil {
+				if err := rows.Scan(&id, &bucket, &jobs, &walltime, &corehours); err != nil {
+					rows.Close()
 					return nil, err
 				}
 
 				if id.Valid {
-					if s, ok := stats[id.String]; ok {
+					key := statsKey{id: id.String, bucket: bucket}
+					if s, ok := stats[key]; ok {
 						s.TotalJobs += int(jobs.Int64)
 						s.TotalWalltime += int(walltime.Int64)
 						s.TotalCoreHours += int(corehours.Int64)
 					} else {
-						stats[id.String] = &model.JobsStatistics{
+						s = &model.JobsStatistics{
 							ID:             id.String,
 							TotalJobs:      int(jobs.Int64),
 							TotalWalltime:  int(walltime.Int64),
 							TotalCoreHours: int(corehours.Int64),
 						}
+						if buckets != nil {
+							start := time.Unix(bucket, 0)
+							s.Bucket = &start
+						}
+						stats[key] = s
 					}
 				}
 			}
+			rows.Close()
 		}
 	}
 
-	if groupBy == nil {
-		query := sq.Select("COUNT(job.id)").From("job").Where("job.duration < ?", ShortJobDuration)
-		query = repository.SecurityCheck(ctx, query)
-		for _, f := range filter {
-			query = repository.BuildWhereClause(f, query)
-		}
-		if err := query.RunWith(r.DB).QueryRow().Scan(&(stats[""].ShortJobs)); err != nil {
-			return nil, err
-		}
-	} else {
-		col := groupBy2column[*groupBy]
-		query := sq.Select(col, "COUNT(job.id)").From("job").Where("job.duration < ?", ShortJobDuration)
-		query = repository.SecurityCheck(ctx, query)
-		for _, f := range filter {
-			query = repository.BuildWhereClause(f, query)
-		}
-		rows, err := query.RunWith(r.DB).Query()
-		if err != nil {
+	rows, err := selectStats("COUNT(job.id)").Where("job.duration < ?", ShortJobDuration()).RunWith(r.DB).Query()
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var id sql.NullString
+		var bucket int64
+		var shortJobs sql.NullInt64
+		if err := rows.Scan(&id, &bucket, &shortJobs); err != nil {
+			rows.Close()
 			return nil, err
 		}
 
-		for rows.Next() {
-			var id sql.NullString
-			var shortJobs sql.NullInt64
-			if err := rows.Scan(&id, &shortJobs); err != nil {
-				return nil, err
-			}
-
-			if id.Valid {
-				stats[id.String].ShortJobs = int(shortJobs.Int64)
-			}
+		if s, ok := stats[statsKey{id: id.String, bucket: bucket}]; id.Valid && ok {
+			s.ShortJobs = int(shortJobs.Int64)
 		}
 	}
+	rows.Close()
 
 	// Calculating the histogram data is expensive, so only do it if needed.
 	// An explicit resolver can not be used because we need to know the filters.
@@ -141,49 +162,164 @@ func (r *queryResolver) jobsStatistics(ctx context.Context, filter []*model.JobF
 	}
 
 	res := make([]*model.JobsStatistics, 0, len(stats))
-	for _, stat := range stats {
+	for key, stat := range stats {
 		res = append(res, stat)
-		id, col := "", ""
-		if groupBy != nil {
-			id = stat.ID
-			col = groupBy2column[*groupBy]
+		if !histogramsNeeded {
+			continue
 		}
 
-		if histogramsNeeded {
-			var err error
-			value := fmt.Sprintf(`CAST(ROUND((CASE WHEN job.job_state = "running" THEN %d - job.start_time ELSE job.duration END) / 3600) as int) as value`, time.Now().Unix())
-			stat.HistDuration, err = r.jobsStatisticsHistogram(ctx, value, filter, id, col)
-			if err != nil {
-				return nil, err
+		// Only the jobs of the group and time bucket of the statistics:
+		key := key
+		restrict := func(query sq.SelectBuilder) sq.SelectBuilder {
+			if groupBy != nil {
+				query = repository.JoinAggregate(query, *groupBy).Where(repository.AggregateColumn(*groupBy)+" = ?", key.id)
 			}
-
-			stat.HistNumNodes, err = r.jobsStatisticsHistogram(ctx, "job.num_nodes as value", filter, id, col)
-			if err != nil {
-				return nil, err
+			if buckets != nil {
+				query = query.Where("job.start_time >= ?", key.bucket).Where("job.start_time < ?", buckets.next(key.bucket))
 			}
+			return query
+		}
+
+		var err error
+		value := fmt.Sprintf("CAST(ROUND(%s / 3600) as int) as value", runningDurationColumn())
+		stat.HistDuration, err = r.jobsStatisticsHistogram(ctx, value, filter, restrict)
+		if err != nil {
+			return nil, err
+		}
+
+		stat.HistNumNodes, err = r.jobsStatisticsHistogram(ctx, "job.num_nodes as value", filter, restrict)
+		if err != nil {
+			return nil, err
 		}
 	}
 
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].ID != res[j].ID {
+			return res[i].ID < res[j].ID
+		}
+		return res[i].Bucket != nil && res[j].Bucket != nil && res[i].Bucket.Before(*res[j].Bucket)
+	})
 	return res, nil
 }
 
-// `value` must be the column grouped by, but renamed to "value". `id` and `col` can optionally be used
-// to add a condition to the query of the kind "<col> = <id>".
-func (r *queryResolver) jobsStatisticsHistogram(ctx context.Context, value string, filters []*model.JobFilter, id, col string) ([]*model.HistoPoint, error) {
+const maxTimeBuckets int = 1000
+
+// The time buckets of the jobsStatistics query as the Unix timestamps of their start, `end` is the end of the last bucket.
+type timeBuckets struct {
+	starts []int64
+	end    int64
+}
+
+// timeBuckets returns the buckets from the earliest to the latest start time of the matched jobs,
+// nil if no jobs matched.
+func (r *queryResolver) timeBuckets(ctx context.Context, filter []*model.JobFilter, bucket *model.TimeBucket) (*timeBuckets, error) {
+	loc := time.UTC
+	if bucket.TimeZone != nil {
+		var err error
+		if loc, err = time.LoadLocation(*bucket.TimeZone); err != nil {
+			return nil, err
+		}
+	}
+
+	query := sq.Select("MIN(job.start_time)", "MAX(job.start_time)").From("job")
+	query = repository.SecurityCheck(ctx, query)
+	for _, f := range filter {
+		query = repository.BuildWhereClause(f, query)
+	}
+
+	var first, last sql.NullInt64
+	if err := query.RunWith(r.DB).QueryRow().Scan(&first, &last); err != nil {
+		return nil, err
+	}
+	if !first.Valid {
+		return nil, nil
+	}
+
+	t := time.Unix(first.Int64, 0).In(loc)
+	switch bucket.Size {
+	case model.TimeBucketSizeDay:
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	case model.TimeBucketSizeWeek:
+		// time.Sunday is 0, weeks start on Monday:
+		t = time.Date(t.Year(), t.Month(), t.Day()-(int(t.Weekday())+6)%7, 0, 0, 0, 0, loc)
+	case model.TimeBucketSizeMonth:
+		t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+	}
+
+	buckets := &timeBuckets{}
+	for t.Unix() <= last.Int64 {
+		if len(buckets.starts) == maxTimeBuckets {
+			return nil, fmt.Errorf("too many time buckets (max: %d), use larger buckets or filter by start time", maxTimeBuckets)
+		}
+		buckets.starts = append(buckets.starts, t.Unix())
+
+		switch bucket.Size {
+		case model.TimeBucketSizeDay:
+			t = t.AddDate(0, 0, 1)
+		case model.TimeBucketSizeWeek:
+			t = t.AddDate(0, 0, 7)
+		case model.TimeBucketSizeMonth:
+			t = t.AddDate(0, 1, 0)
+		}
+	}
+	buckets.end = t.Unix()
+
+	return buckets, nil
+}
+
+// column returns an SQL expression for the start of the bucket of a job (by its start time).
+func (tb *timeBuckets) column() string {
+	if len(tb.starts) == 1 {
+		return strconv.FormatInt(tb.starts[0], 10)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("(CASE")
+	for i := 1; i < len(tb.starts); i++ {
+		fmt.Fprintf(&sb, " WHEN job.start_time < %d THEN %d", tb.starts[i], tb.starts[i-1])
+	}
+	fmt.Fprintf(&sb, " ELSE %d END)", tb.starts[len(tb.starts)-1])
+	return sb.String()
+}
+
+// next returns the end of the bucket starting at `start`.
+func (tb *timeBuckets) next(start int64) int64 {
+	i := sort.Search(len(tb.starts), func(i int) bool { return tb.starts[i] > start })
+	if i == len(tb.starts) {
+		return tb.end
+	}
+	return tb.starts[i]
+}
+
+// runningDurationColumn returns an SQL expression for the duration of jobs in seconds, the duration
+// of running jobs is the time since their start.
+func runningDurationColumn() string {
+	return fmt.Sprintf(`(CASE WHEN job.job_state = "running" THEN %d - job.start_time ELSE job.duration END)`, time.Now().Unix())
+}
+
+// `value` must be the column grouped by, but renamed to "value". `restrict` can optionally add
+// conditions to the query, e.g. to select only the jobs of one group.
+func (r *queryResolver) jobsStatisticsHistogram(
+	ctx context.Context,
+	value string,
+	filters []*model.JobFilter,
+	restrict func(sq.SelectBuilder) sq.SelectBuilder) ([]*model.HistoPoint, error) {
+
 	query := sq.Select(value, "COUNT(job.id) AS count").From("job")
 	query = repository.SecurityCheck(ctx, query)
 	for _, f := range filters {
 		query = repository.BuildWhereClause(f, query)
 	}
 
-	if len(id) != 0 && len(col) != 0 {
-		query = query.Where(col+" = ?", id)
+	if restrict != nil {
+		query = restrict(query)
 	}
 
 	rows, err := query.GroupBy("value").RunWith(r.DB).Query()
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	points := make([]*model.HistoPoint, 0)
 	for rows.Next() {
@@ -226,7 +362,7 @@ func (r *queryResolver) rooflineHeatmap(
 			continue
 		}
 
-		jobdata, err := metricdata.LoadData(job, []string{"flops_any", "mem_bw"}, []schema.MetricScope{schema.MetricScopeNode}, ctx)
+		jobdata, err := metricdata.LoadData(job, []string{"flops_any", "mem_bw"}, []schema.MetricScope{schema.MetricScopeNode}, ctx, 0)
 		if err != nil {
 			return nil, err
 		}
@@ -309,3 +445,146 @@ func (r *queryResolver) jobsFootprints(ctx context.Context, filter []*model.JobF
 		Metrics:   res,
 	}, nil
 }
+
+// Columns of the job table holding per-job footprints, keyed by metric name.
+var footprint2column = map[string]string{
+	"flops_any": "job.flops_any_avg",
+	"mem_bw":    "job.mem_bw_avg",
+	"load":      "job.load_avg",
+	"mem_used":  "job.mem_used_max",
+	"net_bw":    "job.net_bw_avg",
+	"file_bw":   "job.file_bw_avg",
+}
+
+// Helper function for the arrayJob GraphQL query placed here so that schema.resolvers.go is not too full.
+// All statistics are calculated by the database, so that arrays with thousands of members are cheap.
+func (r *queryResolver) arrayJob(ctx context.Context, cluster string, arrayJobId int) (*model.ArrayJob, error) {
+	filter := []*model.JobFilter{{
+		Cluster:    &model.StringInput{Eq: &cluster},
+		ArrayJobID: &arrayJobId,
+	}}
+
+	selectMembers := func(columns ...string) sq.SelectBuilder {
+		query := sq.Select(columns...).From("job")
+		query = repository.SecurityCheck(ctx, query)
+		for _, f := range filter {
+			query = repository.BuildWhereClause(f, query)
+		}
+		return query
+	}
+
+	duration := runningDurationColumn()
+	res := &model.ArrayJob{
+		Cluster:    cluster,
+		ArrayJobID: arrayJobId,
+		Duration:   &schema.MetricStatistics{},
+	}
+
+	var startTime sql.NullInt64
+	var minDuration, avgDuration, maxDuration sql.NullFloat64
+	if err := selectMembers("COUNT(job.id)", "MIN(job.start_time)",
+		"MIN("+duration+")", "AVG("+duration+")", "MAX("+duration+")").
+		RunWith(r.DB).QueryRow().
+		Scan(&res.NumJobs, &startTime, &minDuration, &avgDuration, &maxDuration); err != nil {
+		return nil, err
+	}
+
+	if res.NumJobs == 0 {
+		return nil, nil
+	}
+
+	res.StartTime = time.Unix(startTime.Int64, 0)
+	res.Duration.Min, res.Duration.Avg, res.Duration.Max = minDuration.Float64, avgDuration.Float64, maxDuration.Float64
+
+	// State breakdown
+	rows, err := selectMembers("job.job_state", "COUNT(job.id)").GroupBy("job.job_state").RunWith(r.DB).Query()
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		count := &model.Count{}
+		if err := rows.Scan(&count.Name, &count.Count); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		res.States = append(res.States, count)
+	}
+	rows.Close()
+
+	// `socketsPerNode` and `coresPerSocket` can differ from subcluster to subcluster.
+	rows, err = selectMembers("job.subcluster", "SUM("+duration+")", "SUM("+duration+" * job.num_nodes)").
+		GroupBy("job.subcluster").RunWith(r.DB).Query()
+	if err != nil {
+		return nil, err
+	}
+	var walltime, corehours float64
+	for rows.Next() {
+		var subcluster string
+		var seconds, nodeSeconds sql.NullFloat64
+		if err := rows.Scan(&subcluster, &seconds, &nodeSeconds); err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		walltime += seconds.Float64
+		if sc := archive.GetSubCluster(cluster, subcluster); sc != nil {
+			corehours += nodeSeconds.Float64 * float64(sc.SocketsPerNode*sc.CoresPerSocket)
+		}
+	}
+	rows.Close()
+	res.TotalWalltime = int(math.Round(walltime / 3600))
+	res.TotalCoreHours = int(math.Round(corehours / 3600))
+
+	if res.HistDuration, err = r.jobsStatisticsHistogram(ctx,
+		fmt.Sprintf("CAST(ROUND(%s / 3600) as int) as value", duration), filter, nil); err != nil {
+		return nil, err
+	}
+
+	// Footprints are only available once a job has been archived.
+	metrics := make([]string, 0, len(footprint2column))
+	for metric := range footprint2column {
+		metrics = append(metrics, metric)
+	}
+	sort.Strings(metrics)
+
+	footprintColumns := make([]string, 0, 3*len(metrics))
+	for _, metric := range metrics {
+		col := footprint2column[metric]
+		footprintColumns = append(footprintColumns, "MIN("+col+")", "AVG("+col+")", "MAX("+col+")")
+	}
+
+	footprints := make([]sql.NullFloat64, len(footprintColumns))
+	dest := make([]interface{}, len(footprints))
+	for i := range footprints {
+		dest[i] = &footprints[i]
+	}
+	if err := selectMembers(footprintColumns...).
+		Where("job.job_state != ?", schema.JobStateRunning).
+		Where("job.monitoring_status = ?", schema.MonitoringStatusArchivingSuccessful).
+		RunWith(r.DB).QueryRow().Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	res.Footprints = make([]*model.ArrayJobFootprint, 0, len(metrics))
+	for i, metric := range metrics {
+		min, avg, max := footprints[3*i], footprints[3*i+1], footprints[3*i+2]
+		if !min.Valid {
+			continue
+		}
+
+		res.Footprints = append(res.Footprints, &model.ArrayJobFootprint{
+			Metric: metric,
+			Stats:  &schema.MetricStatistics{Min: min.Float64, Avg: avg.Float64, Max: max.Float64},
+		})
+	}
+
+	return res, nil
+}
+
+// maxPoints returns the optional resolution argument of the metric queries, 0 (full resolution) if not set.
+func maxPoints(resolution *int) int {
+	if resolution == nil || *resolution < 0 {
+		return 0
+	}
+	return *resolution
+}
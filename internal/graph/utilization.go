@@ -0,0 +1,236 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
+	"github.com/ClusterCockpit/cc-backend/internal/repository"
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	sq "github.com/Masterminds/squirrel"
+)
+
+const maxUtilizationBuckets int = 10000
+
+// The allocations of one group, the values are kept as difference arrays (see add) until finished.
+type utilizationAccumulator struct {
+	from, bucketSize               int64
+	nodes, cores, accs             []float64
+	nodesDiff, coresDiff, accsDiff []float64
+}
+
+// Helper function for the clusterUtilization GraphQL query placed here so that schema.resolvers.go is not too full.
+// The allocated resources are the time weighted averages over the buckets of all jobs that ran in the bucket.
+func (r *queryResolver) clusterUtilization(
+	ctx context.Context,
+	cluster string,
+	subCluster *string,
+	from, to time.Time,
+	bucketSize int,
+	groupBy *model.Aggregate) (*model.ClusterUtilization, error) {
+
+	c := archive.GetCluster(cluster)
+	if c == nil {
+		return nil, fmt.Errorf("unknown cluster: %#v", cluster)
+	}
+	if bucketSize <= 0 {
+		return nil, errors.New("the bucket size has to be positive")
+	}
+	if !from.Before(to) {
+		return nil, errors.New("the start of the time range has to be before its end")
+	}
+
+	numBuckets := int((to.Unix() - from.Unix() + int64(bucketSize) - 1) / int64(bucketSize))
+	if numBuckets > maxUtilizationBuckets {
+		return nil, fmt.Errorf("too many buckets (max: %d)", maxUtilizationBuckets)
+	}
+
+	res := &model.ClusterUtilization{
+		From:       from,
+		BucketSize: bucketSize,
+		Series:     make([]*model.UtilizationSeries, 0),
+	}
+	for _, sc := range c.SubClusters {
+		if subCluster != nil && sc.Name != *subCluster {
+			continue
+		}
+		res.TotalNodes += sc.NumberOfNodes
+		res.TotalCores += sc.NumberOfNodes * sc.SocketsPerNode * sc.CoresPerSocket
+		if sc.Topology != nil {
+			res.TotalAccelerators += sc.NumberOfNodes * len(sc.Topology.Accelerators)
+		}
+	}
+
+	groupCol := "''"
+	if groupBy != nil {
+		groupCol = repository.AggregateColumn(*groupBy)
+	}
+
+	// The last bucket is not cut off at `to`.
+	limit := from.Unix() + int64(numBuckets*bucketSize)
+	end := fmt.Sprintf(`(CASE WHEN job.job_state = "running" THEN %d ELSE job.start_time + job.duration END)`, time.Now().Unix())
+	query := sq.Select(groupCol, "job.subcluster", "job.start_time", end,
+		"job.num_nodes", "job.num_hwthreads", "job.num_acc", "job.exclusive").From("job").
+		Where("job.cluster = ?", cluster).
+		Where("job.start_time < ?", limit).
+		Where(end+" > ?", from.Unix())
+	if subCluster != nil {
+		query = query.Where("job.subcluster = ?", *subCluster)
+	}
+	if groupBy != nil {
+		query = repository.JoinAggregate(query, *groupBy)
+	}
+	query = repository.SecurityCheck(ctx, query)
+
+	rows, err := query.RunWith(r.DB).Query()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	groups := map[string]*utilizationAccumulator{}
+	for rows.Next() {
+		var id sql.NullString
+		var subcluster string
+		var start, stop int64
+		var numNodes, numHWThreads, numAcc, exclusive sql.NullInt64
+		if err := rows.Scan(&id, &subcluster, &start, &stop, &numNodes, &numHWThreads, &numAcc, &exclusive); err != nil {
+			return nil, err
+		}
+		if !id.Valid {
+			continue
+		}
+
+		acc, ok := groups[id.String]
+		if !ok {
+			acc = newUtilizationAccumulator(from.Unix(), int64(bucketSize), numBuckets)
+			groups[id.String] = acc
+		}
+
+		if stop > limit {
+			stop = limit
+		}
+		cores := allocatedCores(archive.GetSubCluster(cluster, subcluster),
+			int(numNodes.Int64), int(numHWThreads.Int64), exclusive.Int64 == 1)
+		acc.add(start, stop, float64(numNodes.Int64), cores, float64(numAcc.Int64))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(groups))
+	for id := range groups {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		acc := groups[id]
+		acc.finish()
+		res.Series = append(res.Series, &model.UtilizationSeries{
+			ID:           id,
+			Nodes:        acc.nodes,
+			Cores:        acc.cores,
+			Accelerators: acc.accs,
+		})
+	}
+
+	return res, nil
+}
+
+// allocatedCores returns the number of cores used by a job. Exclusive jobs (and jobs without
+// the number of hwthreads) allocate all cores of their nodes.
+func allocatedCores(sc *schema.SubCluster, numNodes, numHWThreads int, exclusive bool) float64 {
+	if sc == nil {
+		return float64(numHWThreads)
+	}
+
+	if exclusive || numHWThreads == 0 {
+		return float64(numNodes * sc.SocketsPerNode * sc.CoresPerSocket)
+	}
+	if sc.ThreadsPerCore > 1 {
+		return math.Ceil(float64(numHWThreads) / float64(sc.ThreadsPerCore))
+	}
+	return float64(numHWThreads)
+}
+
+func newUtilizationAccumulator(from, bucketSize int64, numBuckets int) *utilizationAccumulator {
+	return &utilizationAccumulator{
+		from:       from,
+		bucketSize: bucketSize,
+		nodes:      make([]float64, numBuckets),
+		cores:      make([]float64, numBuckets),
+		accs:       make([]float64, numBuckets),
+		nodesDiff:  make([]float64, numBuckets+1),
+		coresDiff:  make([]float64, numBuckets+1),
+		accsDiff:   make([]float64, numBuckets+1),
+	}
+}
+
+// add adds the resources allocated from `start` to `stop` (Unix timestamps). The buckets that are only
+// partially covered get their share directly, fully covered buckets are added to the difference arrays
+// so that long jobs do not need a loop over all buckets.
+func (a *utilizationAccumulator) add(start, stop int64, nodes, cores, accs float64) {
+	if start < a.from {
+		start = a.from
+	}
+	if stop <= start {
+		return
+	}
+
+	numBuckets := int64(len(a.nodes))
+	first, last := (start-a.from)/a.bucketSize, (stop-1-a.from)/a.bucketSize
+	if last >= numBuckets {
+		last = numBuckets - 1
+	}
+
+	share := func(bucket, start, stop int64) {
+		lower, upper := a.from+bucket*a.bucketSize, a.from+(bucket+1)*a.bucketSize
+		if start > lower {
+			lower = start
+		}
+		if stop < upper {
+			upper = stop
+		}
+		f := float64(upper-lower) / float64(a.bucketSize)
+		a.nodes[bucket] += f * nodes
+		a.cores[bucket] += f * cores
+		a.accs[bucket] += f * accs
+	}
+
+	share(first, start, stop)
+	if last == first {
+		return
+	}
+	share(last, start, stop)
+
+	if last > first+1 {
+		a.nodesDiff[first+1] += nodes
+		a.nodesDiff[last] -= nodes
+		a.coresDiff[first+1] += cores
+		a.coresDiff[last] -= cores
+		a.accsDiff[first+1] += accs
+		a.accsDiff[last] -= accs
+	}
+}
+
+// finish adds the fully covered buckets from the difference arrays.
+func (a *utilizationAccumulator) finish() {
+	var nodes, cores, accs float64
+	for i := range a.nodes {
+		nodes, cores, accs = nodes+a.nodesDiff[i], cores+a.coresDiff[i], accs+a.accsDiff[i]
+		a.nodes[i] += nodes
+		a.cores[i] += cores
+		a.accs[i] += accs
+	}
+}
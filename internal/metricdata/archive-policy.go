@@ -0,0 +1,127 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/ClusterCockpit/cc-backend/internal/config"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+const defaultArchiveMaxNodes int = 8
+
+// The archive policy for one metric of a job with everything unset replaced by the defaults.
+type metricArchivePolicy struct {
+	scopes   []schema.MetricScope
+	maxNodes int
+	timestep int
+}
+
+func getArchivePolicy(cluster string) *schema.ArchivePolicy {
+	for _, c := range config.Keys.Clusters {
+		if c.Name == cluster {
+			return c.ArchivePolicy
+		}
+	}
+	return nil
+}
+
+// resolveArchivePolicy returns the policy for the metric `mc` of `job`. The returned scopes are the
+// ones that should be loaded for this job: Finer scopes than node are dropped for large jobs, the
+// accelerator scope is only kept for metrics measured per accelerator.
+func resolveArchivePolicy(job *schema.Job, policy *schema.ArchivePolicy, mc *schema.MetricConfig) metricArchivePolicy {
+	res := metricArchivePolicy{
+		scopes:   []schema.MetricScope{schema.MetricScopeNode, schema.MetricScopeCore, schema.MetricScopeAccelerator},
+		maxNodes: defaultArchiveMaxNodes,
+	}
+
+	policies := []*schema.ArchivePolicy{policy}
+	if policy != nil {
+		policies = append(policies, policy.Metrics[mc.Name])
+	}
+	for _, p := range policies {
+		if p == nil {
+			continue
+		}
+		if p.Scopes != nil {
+			res.scopes = p.Scopes
+		}
+		if p.MaxNodes != nil {
+			res.maxNodes = *p.MaxNodes
+		}
+		if p.Timestep != 0 {
+			res.timestep = p.Timestep
+		}
+	}
+
+	scopes := []schema.MetricScope{schema.MetricScopeNode}
+	for _, scope := range res.scopes {
+		if scope == schema.MetricScopeNode || !scope.Valid() || int(job.NumNodes) > res.maxNodes {
+			continue
+		}
+		if scope == schema.MetricScopeAccelerator && (job.NumAcc == 0 || mc.Scope != schema.MetricScopeAccelerator) {
+			continue
+		}
+		scopes = append(scopes, scope)
+	}
+	res.scopes = scopes
+	return res
+}
+
+// key identifies the scopes so that metrics with the same scopes can be loaded together.
+func (p *metricArchivePolicy) key() string {
+	scopes := make([]string, 0, len(p.scopes))
+	for _, scope := range p.scopes {
+		scopes = append(scopes, string(scope))
+	}
+	sort.Strings(scopes)
+	return strings.Join(scopes, ",")
+}
+
+// downsample returns a copy of the metric data with the given timestep. Every value is the average
+// of the values it replaces, NaN if all of them are NaN. The data is returned unchanged if the
+// timestep is not a multiple of the timestep of the data.
+func downsample(jm *schema.JobMetric, timestep int) *schema.JobMetric {
+	if jm.Timestep <= 0 || timestep <= jm.Timestep || timestep%jm.Timestep != 0 {
+		return jm
+	}
+
+	factor := timestep / jm.Timestep
+	res := &schema.JobMetric{
+		Unit:     jm.Unit,
+		Scope:    jm.Scope,
+		Timestep: timestep,
+		Series:   make([]schema.Series, 0, len(jm.Series)),
+	}
+
+	for _, series := range jm.Series {
+		data := make([]schema.Float, 0, (len(series.Data)+factor-1)/factor)
+		for i := 0; i < len(series.Data); i += factor {
+			sum, n := 0.0, 0
+			for j := i; j < i+factor && j < len(series.Data); j++ {
+				if !series.Data[j].IsNaN() {
+					sum += float64(series.Data[j])
+					n += 1
+				}
+			}
+
+			if n == 0 {
+				data = append(data, schema.NaN)
+			} else {
+				data = append(data, schema.Float(sum/float64(n)))
+			}
+		}
+
+		series.Data = data
+		res.Series = append(res.Series, series)
+	}
+
+	if jm.StatisticsSeries != nil {
+		res.AddStatisticsSeries()
+	}
+	return res
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/lrucache"
+)
+
+// The kinds of data in the cache.
+const (
+	CacheJobData  = "jobData"  // See LoadData
+	CacheStats    = "stats"    // See LoadAverages
+	CacheNodeData = "nodeData" // See LoadNodeData
+)
+
+type CacheCounters struct {
+	Hits   int64 `json:"hits"`
+	Misses int64 `json:"misses"`
+}
+
+var cacheCounters map[string]*CacheCounters = map[string]*CacheCounters{
+	CacheJobData:  {},
+	CacheStats:    {},
+	CacheNodeData: {},
+}
+
+// GetCacheCounters returns the number of cache hits and misses since the start by kind of data.
+func GetCacheCounters() map[string]CacheCounters {
+	counters := make(map[string]CacheCounters, len(cacheCounters))
+	for kind, c := range cacheCounters {
+		counters[kind] = CacheCounters{
+			Hits:   atomic.LoadInt64(&c.Hits),
+			Misses: atomic.LoadInt64(&c.Misses),
+		}
+	}
+	return counters
+}
+
+// cacheGet is cache.Get counting the hits and misses for the kind of data.
+func cacheGet(kind, key string, computeValue lrucache.ComputeValue) interface{} {
+	miss := false
+	value := cache.Get(key, func() (interface{}, time.Duration, int) {
+		miss = true
+		return computeValue()
+	})
+
+	if miss {
+		atomic.AddInt64(&cacheCounters[kind].Misses, 1)
+	} else {
+		atomic.AddInt64(&cacheCounters[kind].Hits, 1)
+	}
+	return value
+}
+
+// cacheTTL returns for how long data of a time window ending at `to` is cached. The data
+// of recent windows can still change (running jobs, late samples), older data hardly does.
+func cacheTTL(to time.Time, timestep int) time.Duration {
+	step := time.Duration(timestep) * time.Second
+	switch age := time.Since(to); {
+	case age < 2*step:
+		return step
+	case age < time.Hour:
+		return 10 * time.Minute
+	default:
+		return 5 * time.Hour
+	}
+}
+
+// windowTimestep returns the smallest timestep of the metrics (60 seconds if none is configured).
+// Time windows are rounded to it so that requests within the same timestep share cache entries.
+func windowTimestep(cluster string, metrics []string) int {
+	timestep := 0
+	for _, metric := range metrics {
+		if mc := archive.GetMetricConfig(cluster, metric); mc != nil && mc.Timestep > 0 &&
+			(timestep == 0 || mc.Timestep < timestep) {
+			timestep = mc.Timestep
+		}
+	}
+
+	if timestep == 0 {
+		timestep = 60
+	}
+	return timestep
+}
+
+// roundTime rounds `t` down to a multiple of `timestep` seconds.
+func roundTime(t time.Time, timestep int) time.Time {
+	ts := t.Unix()
+	return time.Unix(ts-ts%int64(timestep), 0)
+}
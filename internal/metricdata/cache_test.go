@@ -0,0 +1,67 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+func TestCacheWindows(t *testing.T) {
+	if err := archive.Init(json.RawMessage(`{"kind": "file","path": "../../test/archive"}`), false); err != nil {
+		t.Fatal(err)
+	}
+
+	repo := &stubRepository{node: "e0101"}
+	metricDataRepos["emmy"] = repo
+	defer delete(metricDataRepos, "emmy")
+
+	// mem_used has a timestep of 60 seconds:
+	metrics := []string{"mem_used"}
+	ctx := context.Background()
+	before := GetCacheCounters()
+
+	to := time.Unix(time.Now().Unix()/60*60, 0)
+	for _, offset := range []time.Duration{5 * time.Second, 20 * time.Second, 70 * time.Second} {
+		if _, err := LoadNodeData("emmy", metrics, nil, nil, to.Add(-time.Hour+offset), to.Add(offset), ctx, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if repo.calls != 2 {
+		t.Errorf("expected the first two node data requests to share the cache entry, %d calls", repo.calls)
+	}
+
+	job := &schema.Job{ID: -46, StartTime: to.Add(-time.Hour)}
+	job.Cluster, job.State, job.NumNodes = "emmy", schema.JobStateRunning, 1
+	for _, duration := range []int32{3600, 3630, 3660} {
+		job.Duration = duration
+		data := [][]schema.Float{{}}
+		if err := LoadAverages(job, metrics, data, ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if repo.calls != 4 {
+		t.Errorf("expected the first two statistics requests to share the cache entry, %d calls", repo.calls)
+	}
+
+	after := GetCacheCounters()
+	for _, kind := range []string{CacheNodeData, CacheStats} {
+		if hits, misses := after[kind].Hits-before[kind].Hits, after[kind].Misses-before[kind].Misses; hits != 1 || misses != 2 {
+			t.Errorf("unexpected %s counters: %d hits, %d misses", kind, hits, misses)
+		}
+	}
+
+	if ttl := cacheTTL(to, 60); ttl != time.Minute {
+		t.Errorf("unexpected TTL of a recent window: %s", ttl)
+	}
+	if ttl := cacheTTL(to.Add(-24*time.Hour), 60); ttl != 5*time.Hour {
+		t.Errorf("unexpected TTL of an old window: %s", ttl)
+	}
+}
@@ -0,0 +1,446 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+var ErrCircuitOpen = errors.New("cc-metric-store: circuit breaker is open, the store is considered to be down")
+
+// Options for the requests to the cc-metric-store. All durations are strings
+// parsable by time.ParseDuration(). Unset options use the defaults below.
+type CCMetricStoreClientConfig struct {
+	Timeout          string `json:"timeout"`          // Timeout for every single request/chunk
+	Retries          *int   `json:"retries"`          // Number of retries after a failed request
+	RetryBackoff     string `json:"retryBackoff"`     // Backoff before the first retry, doubled for every further retry
+	BreakerThreshold int    `json:"breakerThreshold"` // Consecutive failed requests after which the circuit breaker opens
+	BreakerCooldown  string `json:"breakerCooldown"`  // Time the circuit breaker stays open before the store is tried again
+	ChunkDuration    string `json:"chunkDuration"`    // Queries for longer time ranges are split, "0" disables this
+	HostBatchSize    int    `json:"hostBatchSize"`    // Maximum number of hosts per request, -1 disables this
+}
+
+const (
+	ccmsDefaultTimeout          = 10 * time.Second
+	ccmsDefaultRetries          = 2
+	ccmsDefaultRetryBackoff     = 500 * time.Millisecond
+	ccmsDefaultBreakerThreshold = 5
+	ccmsDefaultBreakerCooldown  = 30 * time.Second
+	ccmsDefaultChunkDuration    = 24 * time.Hour
+	ccmsDefaultHostBatchSize    = 64
+)
+
+type ccmsClientOptions struct {
+	timeout       time.Duration
+	retries       int
+	retryBackoff  time.Duration
+	chunkDuration time.Duration
+	hostBatchSize int
+}
+
+func (config *CCMetricStoreClientConfig) options() (ccmsClientOptions, *circuitBreaker, error) {
+	opts := ccmsClientOptions{
+		timeout:       ccmsDefaultTimeout,
+		retries:       ccmsDefaultRetries,
+		retryBackoff:  ccmsDefaultRetryBackoff,
+		chunkDuration: ccmsDefaultChunkDuration,
+		hostBatchSize: ccmsDefaultHostBatchSize,
+	}
+	breaker := &circuitBreaker{threshold: ccmsDefaultBreakerThreshold, cooldown: ccmsDefaultBreakerCooldown}
+
+	durations := []struct {
+		str string
+		dst *time.Duration
+	}{
+		{config.Timeout, &opts.timeout},
+		{config.RetryBackoff, &opts.retryBackoff},
+		{config.ChunkDuration, &opts.chunkDuration},
+		{config.BreakerCooldown, &breaker.cooldown},
+	}
+	for _, d := range durations {
+		if d.str == "" {
+			continue
+		}
+		x, err := time.ParseDuration(d.str)
+		if err != nil || x < 0 {
+			return opts, nil, fmt.Errorf("cc-metric-store: invalid duration %#v", d.str)
+		}
+		*d.dst = x
+	}
+
+	if config.Retries != nil {
+		if *config.Retries < 0 {
+			return opts, nil, fmt.Errorf("cc-metric-store: invalid number of retries: %d", *config.Retries)
+		}
+		opts.retries = *config.Retries
+	}
+	if config.BreakerThreshold > 0 {
+		breaker.threshold = config.BreakerThreshold
+	}
+	if config.HostBatchSize != 0 {
+		opts.hostBatchSize = config.HostBatchSize
+	}
+	if opts.timeout == 0 {
+		opts.timeout = ccmsDefaultTimeout
+	}
+
+	return opts, breaker, nil
+}
+
+// A circuitBreaker is closed as long as requests succeed. After `threshold` consecutive
+// failures, it opens and requests fail immediately. After `cooldown`, a single request
+// is allowed (half-open): If it succeeds, the breaker is closed again.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	lock      sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func (cb *circuitBreaker) allow() bool {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+
+	if cb.failures < cb.threshold {
+		return true
+	}
+	if time.Now().Before(cb.openUntil) || cb.probing {
+		return false
+	}
+	cb.probing = true
+	return true
+}
+
+// release ends a request without changing the state of the breaker.
+func (cb *circuitBreaker) release() {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	cb.probing = false
+}
+
+func (cb *circuitBreaker) report(err error) {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+
+	cb.probing = false
+	if err == nil {
+		cb.failures = 0
+		return
+	}
+
+	cb.failures += 1
+	if cb.failures >= cb.threshold {
+		if cb.failures == cb.threshold {
+			log.Warnf("cc-metric-store: opening circuit breaker after %d failed requests: %s", cb.failures, err.Error())
+		}
+		cb.openUntil = time.Now().Add(cb.cooldown)
+	}
+}
+
+// An error after which a request should be retried.
+type retryableError struct {
+	err error
+}
+
+func (e retryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e retryableError) Unwrap() error {
+	return e.err
+}
+
+// doRequest sends the query to the cc-metric-store. Queries for long time ranges or many hosts
+// are split into multiple requests, the results are merged again. Every request is retried
+// on errors that are probably temporary.
+func (ccms *CCMetricStore) doRequest(
+	ctx context.Context,
+	body *ApiQueryRequest) (*ApiQueryResponse, error) {
+
+	// The order of the results of `for-all-nodes` queries is not known in advance,
+	// so that they can not be merged reliably.
+	if len(body.ForAllNodes) != 0 {
+		return ccms.doRequestWithRetries(ctx, body)
+	}
+
+	batches := ccms.hostBatches(body.Queries)
+	align, timestep := requestTimesteps(body)
+	chunks := ccms.timeChunks(body.From, body.To, align, timestep)
+	if len(batches) == 1 && len(chunks) == 1 {
+		return ccms.doRequestWithRetries(ctx, body)
+	}
+
+	res := &ApiQueryResponse{Results: make([][]ApiMetricData, len(body.Queries))}
+	for _, batch := range batches {
+		for _, chunk := range chunks {
+			req := *body
+			req.From, req.To = chunk[0], chunk[1]
+			req.Queries = make([]ApiQuery, 0, len(batch))
+			for _, i := range batch {
+				req.Queries = append(req.Queries, body.Queries[i])
+			}
+
+			chunkRes, err := ccms.doRequestWithRetries(ctx, &req)
+			if err != nil {
+				return nil, err
+			}
+			if len(chunkRes.Results) != len(batch) {
+				return nil, fmt.Errorf("cc-metric-store: expected %d results, got %d", len(batch), len(chunkRes.Results))
+			}
+
+			for j, i := range batch {
+				res.Results[i] = mergeApiMetricData(res.Results[i], chunkRes.Results[j], body.WithData)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// hostBatches groups the indices of the queries so that every group contains the queries
+// of at most `hostBatchSize` hosts.
+func (ccms *CCMetricStore) hostBatches(queries []ApiQuery) [][]int {
+	if ccms.opts.hostBatchSize <= 0 {
+		all := make([]int, len(queries))
+		for i := range queries {
+			all[i] = i
+		}
+		return [][]int{all}
+	}
+
+	batches := [][]int{}
+	batchOfHost := map[string]int{}
+	hostsInLastBatch := 0
+	for i, q := range queries {
+		b, ok := batchOfHost[q.Hostname]
+		if !ok {
+			if len(batches) == 0 || hostsInLastBatch == ccms.opts.hostBatchSize {
+				batches = append(batches, []int{})
+				hostsInLastBatch = 0
+			}
+			b = len(batches) - 1
+			batchOfHost[q.Hostname] = b
+			hostsInLastBatch += 1
+		}
+		batches[b] = append(batches[b], i)
+	}
+
+	if len(batches) == 0 {
+		batches = append(batches, []int{})
+	}
+	return batches
+}
+
+// timeChunks splits the time range [from, to] into chunks of at most `chunkDuration`. The chunks
+// start at multiples of `align` (so that every chunk starts with a sample of every metric) and
+// end one `timestep` before the next chunk, as both ends of a range are inclusive.
+func (ccms *CCMetricStore) timeChunks(from, to, align, timestep int64) [][2]int64 {
+	step := int64(ccms.opts.chunkDuration.Seconds())
+	if step <= 0 || to-from <= step {
+		return [][2]int64{{from, to}}
+	}
+
+	step = (step + align - 1) / align * align
+	chunks := make([][2]int64, 0, (to-from)/step+2)
+	for start := from; start <= to; {
+		next := (start/step + 1) * step
+		if next-timestep >= to {
+			chunks = append(chunks, [2]int64{start, to})
+			break
+		}
+		chunks = append(chunks, [2]int64{start, next - timestep})
+		start = next
+	}
+	return chunks
+}
+
+// requestTimesteps returns the least common multiple and the greatest common divisor of the
+// timesteps of all metrics queried (60 seconds for metrics that are not configured).
+func requestTimesteps(body *ApiQueryRequest) (lcm, gcd int64) {
+	gcdOf := func(a, b int64) int64 {
+		for b != 0 {
+			a, b = b, a%b
+		}
+		return a
+	}
+
+	for _, q := range body.Queries {
+		timestep := int64(60)
+		if mc := archive.GetMetricConfig(body.Cluster, q.Metric); mc != nil && mc.Timestep > 0 {
+			timestep = int64(mc.Timestep)
+		}
+
+		if lcm == 0 {
+			lcm, gcd = timestep, timestep
+			continue
+		}
+		lcm = lcm / gcdOf(lcm, timestep) * timestep
+		gcd = gcdOf(gcd, timestep)
+	}
+
+	if lcm == 0 {
+		lcm, gcd = 60, 60
+	}
+	return lcm, gcd
+}
+
+// mergeApiMetricData appends the results of the next chunk of a query.
+func mergeApiMetricData(prev, next []ApiMetricData, withData bool) []ApiMetricData {
+	if prev == nil {
+		return next
+	}
+
+	for i := range prev {
+		if i >= len(next) {
+			break
+		}
+
+		a, b := &prev[i], next[i]
+		if a.Error != nil {
+			continue
+		}
+		if b.Error != nil {
+			a.Error = b.Error
+			continue
+		}
+
+		// The average is weighted by the number of values, or the covered time if there is no data.
+		wa, wb := float64(a.To-a.From), float64(b.To-b.From)
+		if withData {
+			wa, wb = float64(len(a.Data)), float64(len(b.Data))
+		}
+
+		switch {
+		case a.Avg.IsNaN() || wa == 0:
+			a.Avg = b.Avg
+		case b.Avg.IsNaN() || wb == 0:
+		default:
+			a.Avg = schema.Float((float64(a.Avg)*wa + float64(b.Avg)*wb) / (wa + wb))
+		}
+		if a.Min.IsNaN() || (!b.Min.IsNaN() && b.Min < a.Min) {
+			a.Min = b.Min
+		}
+		if a.Max.IsNaN() || (!b.Max.IsNaN() && b.Max > a.Max) {
+			a.Max = b.Max
+		}
+
+		a.To = b.To
+		a.Data = append(a.Data, b.Data...)
+	}
+
+	return prev
+}
+
+// doRequestWithRetries sends a single request. Queries do not change anything in the store,
+// so it is retried with a jittered exponential backoff if the failure is probably temporary.
+func (ccms *CCMetricStore) doRequestWithRetries(
+	ctx context.Context,
+	body *ApiQueryRequest) (*ApiQueryResponse, error) {
+
+	buf := &bytes.Buffer{}
+	if err := json.NewEncoder(buf).Encode(body); err != nil {
+		return nil, err
+	}
+
+	backoff := ccms.opts.retryBackoff
+	for attempt := 0; ; attempt++ {
+		if !ccms.breaker.allow() {
+			return nil, ErrCircuitOpen
+		}
+
+		res, err := ccms.doSingleRequest(ctx, buf.Bytes())
+		var retryable retryableError
+		isRetryable := errors.As(err, &retryable)
+		switch {
+		case ctx.Err() != nil:
+			// Cancellations by the caller say nothing about the health of the store.
+			ccms.breaker.release()
+		case isRetryable:
+			ccms.breaker.report(err)
+		default:
+			// Also errors like "400 Bad Request" show that the store is up.
+			ccms.breaker.report(nil)
+		}
+		if err == nil {
+			return res, nil
+		}
+
+		if !isRetryable || attempt >= ccms.opts.retries || ctx.Err() != nil {
+			return nil, err
+		}
+
+		// Full jitter between backoff/2 and backoff*3/2.
+		wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff)+1))
+		log.Warnf("cc-metric-store: request failed (attempt %d of %d), retrying in %s: %s",
+			attempt+1, ccms.opts.retries+1, wait.Round(time.Millisecond), err.Error())
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+
+		if backoff < math.MaxInt64/2 {
+			backoff *= 2
+		}
+	}
+}
+
+func (ccms *CCMetricStore) doSingleRequest(
+	ctx context.Context,
+	body []byte) (*ApiQueryResponse, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, ccms.opts.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ccms.queryEndpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if ccms.jwt != "" {
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", ccms.jwt))
+	}
+
+	res, err := ccms.client.Do(req)
+	if err != nil {
+		// Network errors and timeouts.
+		return nil, retryableError{err}
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, res.Body)
+		err := fmt.Errorf("'%s': HTTP Status: %s", ccms.queryEndpoint, res.Status)
+		if res.StatusCode >= 500 || res.StatusCode == http.StatusTooManyRequests {
+			return nil, retryableError{err}
+		}
+		return nil, err
+	}
+
+	var resBody ApiQueryResponse
+	if err := json.NewDecoder(bufio.NewReader(res.Body)).Decode(&resBody); err != nil {
+		return nil, retryableError{err}
+	}
+
+	return &resBody, nil
+}
@@ -5,8 +5,6 @@
 package metricdata
 
 import (
-	"bufio"
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -28,6 +26,9 @@ type CCMetricStoreConfig struct {
 	// name than in the `metricConfig` section of the 'cluster.json',
 	// provide this optional mapping of local to remote name for this metric.
 	Renamings map[string]string `json:"metricRenamings"`
+
+	// Timeouts, retries, circuit breaking and splitting of large queries.
+	CCMetricStoreClientConfig
 }
 
 type CCMetricStore struct {
@@ -37,6 +38,8 @@ type CCMetricStore struct {
 	client        http.Client
 	here2there    map[string]string
 	there2here    map[string]string
+	opts          ccmsClientOptions
+	breaker       *circuitBreaker
 }
 
 type ApiQueryRequest struct {
@@ -84,9 +87,13 @@ func (ccms *CCMetricStore) Init(rawConfig json.RawMessage) error {
 	ccms.url = config.Url
 	ccms.queryEndpoint = fmt.Sprintf("%s/api/query", config.Url)
 	ccms.jwt = config.Token
-	ccms.client = http.Client{
-		Timeout: 10 * time.Second,
+	opts, breaker, err := config.CCMetricStoreClientConfig.options()
+	if err != nil {
+		return err
 	}
+	ccms.opts, ccms.breaker = opts, breaker
+	// The timeout is set per request in doSingleRequest.
+	ccms.client = http.Client{}
 
 	if config.Renamings != nil {
 		ccms.here2there = config.Renamings
@@ -118,40 +125,6 @@ func (ccms *CCMetricStore) toLocalName(metric string) string {
 	return metric
 }
 
-func (ccms *CCMetricStore) doRequest(
-	ctx context.Context,
-	body *ApiQueryRequest) (*ApiQueryResponse, error) {
-
-	buf := &bytes.Buffer{}
-	if err := json.NewEncoder(buf).Encode(body); err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ccms.queryEndpoint, buf)
-	if err != nil {
-		return nil, err
-	}
-	if ccms.jwt != "" {
-		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", ccms.jwt))
-	}
-
-	res, err := ccms.client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("'%s': HTTP Status: %s", ccms.queryEndpoint, res.Status)
-	}
-
-	var resBody ApiQueryResponse
-	if err := json.NewDecoder(bufio.NewReader(res.Body)).Decode(&resBody); err != nil {
-		return nil, err
-	}
-
-	return &resBody, nil
-}
-
 func (ccms *CCMetricStore) LoadData(
 	job *schema.Job,
 	metrics []string,
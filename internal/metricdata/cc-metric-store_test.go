@@ -0,0 +1,243 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+// A fake cc-metric-store: The first `failures` requests fail with 503, every other
+// request gets one value per minute that equals the minute since the epoch (both ends of the
+// requested time range are inclusive).
+func fakeCCMetricStore(t *testing.T, failures int32, requests *[]ApiQueryRequest) (*httptest.Server, *int32) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&count, 1) <= failures {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var req ApiQueryRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if requests != nil {
+			*requests = append(*requests, req)
+		}
+
+		res := ApiQueryResponse{Results: make([][]ApiMetricData, 0, len(req.Queries))}
+		for range req.Queries {
+			data := ApiMetricData{From: req.From, To: req.To, Min: schema.NaN, Max: schema.NaN}
+			sum := 0.0
+			for ts := (req.From + 59) / 60 * 60; ts <= req.To; ts += 60 {
+				x := schema.Float(ts / 60)
+				data.Data = append(data.Data, x)
+				sum += float64(x)
+				if data.Min.IsNaN() || x < data.Min {
+					data.Min = x
+				}
+				if data.Max.IsNaN() || x > data.Max {
+					data.Max = x
+				}
+			}
+			data.Avg = schema.Float(sum / float64(len(data.Data)))
+			res.Results = append(res.Results, []ApiMetricData{data})
+		}
+		json.NewEncoder(rw).Encode(res)
+	}))
+	return server, &count
+}
+
+func newTestCCMetricStore(t *testing.T, url string, config string) *CCMetricStore {
+	ccms := &CCMetricStore{}
+	if err := ccms.Init(json.RawMessage(`{"kind": "cc-metric-store", "url": "` + url + `", ` + config + `}`)); err != nil {
+		t.Fatal(err)
+	}
+	return ccms
+}
+
+func TestCCMetricStoreRetries(t *testing.T) {
+	server, count := fakeCCMetricStore(t, 2, nil)
+	defer server.Close()
+	ccms := newTestCCMetricStore(t, server.URL, `"retries": 2, "retryBackoff": "1ms"`)
+
+	req := &ApiQueryRequest{From: 0, To: 600, WithData: true, Queries: []ApiQuery{{Hostname: "a", Metric: "load"}}}
+	res, err := ccms.doRequest(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *count != 3 || len(res.Results) != 1 || len(res.Results[0][0].Data) != 11 {
+		t.Errorf("unexpected result after %d requests: %#v", *count, res)
+	}
+}
+
+func TestCCMetricStoreCircuitBreaker(t *testing.T) {
+	server, count := fakeCCMetricStore(t, 1000, nil)
+	defer server.Close()
+	ccms := newTestCCMetricStore(t, server.URL, `"retries": 0, "breakerThreshold": 3, "breakerCooldown": "1h"`)
+
+	req := &ApiQueryRequest{From: 0, To: 600, Queries: []ApiQuery{{Hostname: "a", Metric: "load"}}}
+	for i := 0; i < 3; i++ {
+		if _, err := ccms.doRequest(context.Background(), req); err == nil || errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("expected the request to fail, got: %v", err)
+		}
+	}
+
+	// The breaker is open now, the store is not asked anymore.
+	if _, err := ccms.doRequest(context.Background(), req); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("expected ErrCircuitOpen, got: %v", err)
+	}
+	if *count != 3 {
+		t.Errorf("expected 3 requests, got %d", *count)
+	}
+}
+
+func TestCCMetricStoreChunking(t *testing.T) {
+	var requests []ApiQueryRequest
+	server, _ := fakeCCMetricStore(t, 0, &requests)
+	defer server.Close()
+	ccms := newTestCCMetricStore(t, server.URL, `"chunkDuration": "10m", "hostBatchSize": 2`)
+
+	req := &ApiQueryRequest{
+		From:     0,
+		To:       1500,
+		WithData: true,
+		Queries: []ApiQuery{
+			{Hostname: "a", Metric: "load"},
+			{Hostname: "b", Metric: "load"},
+			{Hostname: "c", Metric: "load"},
+			{Hostname: "a", Metric: "mem"},
+		},
+	}
+	res, err := ccms.doRequest(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2 host batches ({a, b} and {c}) times 3 chunks (10m, 10m, 5m). Chunks end one timestep
+	// before the next one starts, so that no sample is returned twice.
+	if len(requests) != 6 {
+		t.Fatalf("expected 6 requests, got %d", len(requests))
+	}
+	if len(requests[0].Queries) != 3 || requests[0].To != 540 || requests[1].From != 600 || requests[1].To != 1140 ||
+		requests[2].From != 1200 || requests[2].To != 1500 {
+		t.Errorf("unexpected requests: %#v", requests)
+	}
+
+	if len(res.Results) != 4 {
+		t.Fatalf("expected 4 results, got %d", len(res.Results))
+	}
+	for _, r := range res.Results {
+		data := r[0]
+		if len(data.Data) != 26 || data.From != 0 || data.To != 1500 {
+			t.Fatalf("unexpected data: %#v", data)
+		}
+		for i, x := range data.Data {
+			if x != schema.Float(i) {
+				t.Fatalf("unexpected value at index %d: %f", i, x)
+			}
+		}
+		if data.Min != 0 || data.Max != 25 || data.Avg != 12.5 {
+			t.Errorf("unexpected statistics: min=%f, max=%f, avg=%f", data.Min, data.Max, data.Avg)
+		}
+	}
+
+	// Chunks are aligned to multiples of the chunk duration, not to the start of the request.
+	requests = requests[:0]
+	req.From = 30
+	if res, err = ccms.doRequest(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) != 6 || requests[0].From != 30 || requests[0].To != 540 || requests[2].From != 1200 {
+		t.Errorf("unexpected requests: %#v", requests)
+	}
+	if data := res.Results[0][0]; len(data.Data) != 25 || data.Data[0] != 1 || data.Data[24] != 25 {
+		t.Errorf("unexpected data: %#v", data)
+	}
+}
+
+func TestCCMetricStoreArchiveGPUJob(t *testing.T) {
+	server, _ := fakeCCMetricStore(t, 0, nil)
+	defer server.Close()
+
+	cluster := &schema.Cluster{
+		Name: "gpu-test",
+		MetricConfig: []*schema.MetricConfig{
+			{Name: "cpu_load", Scope: schema.MetricScopeCore, Timestep: 60},
+			{Name: "mem_bw", Scope: schema.MetricScopeMemoryDomain, Timestep: 60},
+			{Name: "acc_utilization", Scope: schema.MetricScopeAccelerator, Timestep: 60},
+		},
+		SubClusters: []*schema.SubCluster{{
+			Name: "main",
+			Topology: &schema.Topology{
+				Node:         []int{0, 1, 2, 3},
+				Socket:       [][]int{{0, 1, 2, 3}},
+				MemoryDomain: [][]int{{0, 1, 2, 3}},
+				Core:         [][]int{{0}, {1}, {2}, {3}},
+				Accelerators: []*schema.Accelerator{{ID: "00000000:3B:00.0"}, {ID: "00000000:5E:00.0"}},
+			},
+		}},
+	}
+	archive.Clusters = append(archive.Clusters, cluster)
+	metricDataRepos[cluster.Name] = newTestCCMetricStore(t, server.URL, `"retries": 0`)
+	defer func() {
+		archive.Clusters = archive.Clusters[:len(archive.Clusters)-1]
+		delete(metricDataRepos, cluster.Name)
+	}()
+
+	job := &schema.Job{
+		BaseJob: schema.BaseJob{
+			Cluster:    cluster.Name,
+			SubCluster: "main",
+			NumNodes:   1,
+			NumAcc:     2,
+			Duration:   600,
+			State:      schema.JobStateCompleted,
+			Resources: []*schema.Resource{{
+				Hostname:     "g0101",
+				HWThreads:    []int{0, 1, 2, 3},
+				Accelerators: []string{"00000000:3B:00.0", "00000000:5E:00.0"},
+			}},
+		},
+		StartTime: time.Unix(60000, 0),
+	}
+
+	jobMeta, err := ArchiveJob(job, context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the metric measured per accelerator is archived with the accelerator scope.
+	for metric, scopes := range map[string][]schema.MetricScope{
+		"cpu_load":        {schema.MetricScopeNode, schema.MetricScopeCore},
+		"mem_bw":          {schema.MetricScopeNode, schema.MetricScopeMemoryDomain},
+		"acc_utilization": {schema.MetricScopeNode, schema.MetricScopeAccelerator},
+	} {
+		archived, ok := jobMeta.Archived[metric]
+		if !ok || len(archived.Scopes) != len(scopes) {
+			t.Errorf("unexpected archived scopes of %s: %#v", metric, archived)
+			continue
+		}
+		for i, scope := range scopes {
+			if archived.Scopes[i] != scope {
+				t.Errorf("unexpected archived scopes of %s: %#v", metric, archived.Scopes)
+			}
+		}
+		if stats, ok := jobMeta.Statistics[metric]; !ok || stats.Min != 1000 || stats.Max != 1010 {
+			t.Errorf("unexpected statistics of %s: %#v", metric, stats)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/ClusterCockpit/cc-backend/internal/expression"
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+// Parsed expressions of derived metrics, keyed by "<cluster>/<metric>".
+var derivedMetrics sync.Map
+
+// getDerivedMetric returns the parsed expression if `metric` is a derived metric, nil otherwise.
+func getDerivedMetric(cluster, metric string) (*expression.Expression, error) {
+	mc := archive.GetMetricConfig(cluster, metric)
+	if mc == nil || mc.Expression == "" {
+		return nil, nil
+	}
+
+	key := cluster + "/" + metric
+	if expr, ok := derivedMetrics.Load(key); ok {
+		return expr.(*expression.Expression), nil
+	}
+
+	expr, err := expression.Parse(mc.Expression)
+	if err != nil {
+		return nil, fmt.Errorf("metric '%s' of cluster '%s': %w", metric, cluster, err)
+	}
+	derivedMetrics.Store(key, expr)
+	return expr, nil
+}
+
+// validateDerivedMetrics checks that the expressions of all derived metrics can be parsed,
+// only reference metrics of the same cluster and do not depend on themselves.
+func validateDerivedMetrics() error {
+	for _, cluster := range archive.Clusters {
+		// 1: being visited, 2: done
+		state := make(map[string]int)
+		var visit func(metric string) error
+		visit = func(metric string) error {
+			switch state[metric] {
+			case 1:
+				return fmt.Errorf("metric '%s' of cluster '%s': the expression depends on the metric itself", metric, cluster.Name)
+			case 2:
+				return nil
+			}
+
+			state[metric] = 1
+			expr, err := getDerivedMetric(cluster.Name, metric)
+			if err != nil {
+				return err
+			}
+			if expr != nil {
+				for _, operand := range expr.Operands {
+					if archive.GetMetricConfig(cluster.Name, operand) == nil {
+						return fmt.Errorf("metric '%s' of cluster '%s': unknown metric '%s' in expression", metric, cluster.Name, operand)
+					}
+					if err := visit(operand); err != nil {
+						return err
+					}
+				}
+			}
+			state[metric] = 2
+			return nil
+		}
+
+		for _, mc := range cluster.MetricConfig {
+			if err := visit(mc.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// expandMetrics replaces derived metrics by the metrics they are computed from. The second
+// return value is false if there are no derived metrics in the list.
+func expandMetrics(cluster string, metrics []string) ([]string, bool) {
+	expanded := make([]string, 0, len(metrics))
+	seen := make(map[string]bool, len(metrics))
+	hasDerived := false
+
+	var expand func(metric string, depth int)
+	expand = func(metric string, depth int) {
+		if seen[metric] {
+			return
+		}
+		seen[metric] = true
+
+		expr, err := getDerivedMetric(cluster, metric)
+		if err != nil {
+			log.Warnf("ignoring derived metric: %s", err.Error())
+			return
+		}
+		if expr == nil || depth > len(archive.GetCluster(cluster).MetricConfig) {
+			expanded = append(expanded, metric)
+			return
+		}
+
+		hasDerived = true
+		for _, operand := range expr.Operands {
+			expand(operand, depth+1)
+		}
+	}
+
+	for _, metric := range metrics {
+		expand(metric, 0)
+	}
+	return expanded, hasDerived
+}
+
+// deriveMetrics adds every derived metric in `metrics` that is not already part of `jobData`
+// and can be computed from the data available. If `metrics` is nil, all derived metrics of the
+// cluster are tried.
+func deriveMetrics(cluster string, jobData schema.JobData, metrics []string) {
+	if metrics == nil {
+		for _, mc := range archive.GetCluster(cluster).MetricConfig {
+			if mc.Expression != "" {
+				metrics = append(metrics, mc.Name)
+			}
+		}
+	}
+
+	visiting := make(map[string]bool)
+	var derive func(metric string)
+	derive = func(metric string) {
+		if _, ok := jobData[metric]; ok || visiting[metric] {
+			return
+		}
+
+		expr, err := getDerivedMetric(cluster, metric)
+		if err != nil || expr == nil {
+			return
+		}
+
+		visiting[metric] = true
+		operands := make([]map[schema.MetricScope]*schema.JobMetric, 0, len(expr.Operands))
+		for _, operand := range expr.Operands {
+			derive(operand)
+			data, ok := jobData[operand]
+			if !ok {
+				return
+			}
+			operands = append(operands, data)
+		}
+
+		data := deriveJobMetrics(archive.GetMetricConfig(cluster, metric), expr, operands)
+		if len(data) != 0 {
+			jobData[metric] = data
+		}
+	}
+
+	for _, metric := range metrics {
+		derive(metric)
+	}
+}
+
+// deriveJobMetrics evaluates the expression for every scope at which all operands are available
+// with the same timestep. Series are matched by hostname and id.
+func deriveJobMetrics(
+	mc *schema.MetricConfig,
+	expr *expression.Expression,
+	operands []map[schema.MetricScope]*schema.JobMetric) map[schema.MetricScope]*schema.JobMetric {
+
+	res := make(map[schema.MetricScope]*schema.JobMetric)
+
+scopes:
+	for scope, first := range operands[0] {
+		seriesByKey := make([]map[string]*schema.Series, len(operands))
+		for i, operand := range operands {
+			jm, ok := operand[scope]
+			if !ok || jm.Timestep != first.Timestep {
+				continue scopes
+			}
+
+			seriesByKey[i] = make(map[string]*schema.Series, len(jm.Series))
+			for j := range jm.Series {
+				seriesByKey[i][seriesKey(&jm.Series[j])] = &jm.Series[j]
+			}
+		}
+
+		jm := &schema.JobMetric{
+			Unit:     mc.Unit,
+			Scope:    scope,
+			Timestep: first.Timestep,
+			Series:   make([]schema.Series, 0, len(first.Series)),
+		}
+
+	series:
+		for _, s := range first.Series {
+			key := seriesKey(&s)
+			matched := make([]*schema.Series, len(operands))
+			length := math.MaxInt32
+			for i := range operands {
+				m, ok := seriesByKey[i][key]
+				if !ok {
+					continue series
+				}
+				matched[i] = m
+				if len(m.Data) < length {
+					length = len(m.Data)
+				}
+			}
+
+			data := make([]schema.Float, length)
+			values := make([]float64, len(operands))
+			for t := 0; t < length; t++ {
+				for i, m := range matched {
+					values[i] = float64(m.Data[t])
+				}
+				x := expr.Eval(values)
+				if math.IsInf(x, 0) {
+					x = math.NaN()
+				}
+				data[t] = schema.Float(x)
+			}
+
+			jm.Series = append(jm.Series, schema.Series{
+				Hostname:   s.Hostname,
+				Id:         s.Id,
+				Statistics: seriesStatistics(data),
+				Data:       data,
+			})
+		}
+
+		if len(jm.Series) != 0 {
+			res[scope] = jm
+		}
+	}
+
+	return res
+}
+
+func seriesKey(s *schema.Series) string {
+	if s.Id == nil {
+		return s.Hostname
+	}
+	return fmt.Sprintf("%s/%d", s.Hostname, *s.Id)
+}
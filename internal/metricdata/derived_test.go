@@ -0,0 +1,91 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+func TestDeriveMetrics(t *testing.T) {
+	if err := archive.Init(json.RawMessage(`{"kind": "file","path": "../../test/archive"}`), false); err != nil {
+		t.Fatal(err)
+	}
+
+	cluster := archive.GetCluster("emmy")
+	cluster.MetricConfig = append(cluster.MetricConfig,
+		&schema.MetricConfig{Name: "flops_derived", Unit: "GF/s", Scope: schema.MetricScopeHWThread, Timestep: 60, Expression: "flops_dp*2 + flops_sp"},
+		&schema.MetricConfig{Name: "flops_double", Unit: "GF/s", Scope: schema.MetricScopeHWThread, Timestep: 60, Expression: "flops_derived * 2"})
+	defer func() {
+		cluster.MetricConfig = cluster.MetricConfig[:len(cluster.MetricConfig)-2]
+	}()
+
+	if err := validateDerivedMetrics(); err != nil {
+		t.Fatal(err)
+	}
+
+	metrics, hasDerived := expandMetrics("emmy", []string{"mem_used", "flops_double"})
+	if !hasDerived || strings.Join(metrics, ",") != "mem_used,flops_dp,flops_sp" {
+		t.Errorf("unexpected expansion: %v", metrics)
+	}
+
+	id0, id1 := 0, 1
+	jobData := schema.JobData{
+		"flops_dp": {
+			schema.MetricScopeNode: {Timestep: 60, Series: []schema.Series{{Hostname: "e0101", Data: []schema.Float{1, 2, schema.NaN}}}},
+			schema.MetricScopeCore: {Timestep: 60, Series: []schema.Series{
+				{Hostname: "e0101", Id: &id0, Data: []schema.Float{1, 1}},
+				{Hostname: "e0101", Id: &id1, Data: []schema.Float{2, 2}},
+			}},
+		},
+		"flops_sp": {
+			schema.MetricScopeNode: {Timestep: 60, Series: []schema.Series{{Hostname: "e0101", Data: []schema.Float{1, 1, 1}}}},
+			schema.MetricScopeCore: {Timestep: 60, Series: []schema.Series{
+				{Hostname: "e0101", Id: &id1, Data: []schema.Float{3, 3}},
+			}},
+		},
+	}
+
+	deriveMetrics("emmy", jobData, []string{"flops_double"})
+	node := jobData["flops_double"][schema.MetricScopeNode]
+	if node == nil || node.Unit != "GF/s" || len(node.Series) != 1 {
+		t.Fatalf("unexpected node data: %#v", jobData["flops_double"])
+	}
+	if data := node.Series[0].Data; data[0] != 6 || data[1] != 10 || !data[2].IsNaN() {
+		t.Errorf("unexpected data: %v", data)
+	}
+	if s := node.Series[0].Statistics; s.Min != 6 || s.Max != 10 || s.Avg != 8 {
+		t.Errorf("unexpected statistics: %#v", s)
+	}
+
+	// Only core 1 is available for both operands.
+	core := jobData["flops_double"][schema.MetricScopeCore]
+	if core == nil || len(core.Series) != 1 || *core.Series[0].Id != 1 || core.Series[0].Data[0] != 14 {
+		t.Errorf("unexpected core data: %#v", core)
+	}
+
+	removeUnrequested(jobData, []string{"flops_double"})
+	if len(jobData) != 1 {
+		t.Errorf("expected only the requested metric, got: %v", jobData)
+	}
+
+	// Cycles and unknown metrics are rejected:
+	cluster.MetricConfig[len(cluster.MetricConfig)-2].Expression = "flops_double + 1"
+	derivedMetrics.Delete("emmy/flops_derived")
+	if err := validateDerivedMetrics(); err == nil || !strings.Contains(err.Error(), "depends on the metric itself") {
+		t.Errorf("expected a cycle to be detected, got: %v", err)
+	}
+	cluster.MetricConfig[len(cluster.MetricConfig)-2].Expression = "unknown + 1"
+	derivedMetrics.Delete("emmy/flops_derived")
+	if err := validateDerivedMetrics(); err == nil || !strings.Contains(err.Error(), "unknown metric") {
+		t.Errorf("expected an unknown metric to be detected, got: %v", err)
+	}
+	derivedMetrics.Delete("emmy/flops_derived")
+	derivedMetrics.Delete("emmy/flops_double")
+}
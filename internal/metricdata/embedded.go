@@ -0,0 +1,106 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/internal/metricstore"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+// EmbeddedMetricStore reads the metric data from the in-process metric store
+// (see package metricstore and the `metric-store` option of the config.json).
+type EmbeddedMetricStore struct{}
+
+func (ems *EmbeddedMetricStore) Init(_ json.RawMessage) error {
+	if !metricstore.Enabled() {
+		return errors.New("embedded: the metric store is not enabled (option `metric-store` of the config.json)")
+	}
+	return nil
+}
+
+func (ems *EmbeddedMetricStore) loadNativeSeries(
+	ctx context.Context,
+	cluster string,
+	metrics map[string]*schema.MetricConfig,
+	hosts []string,
+	from, to int64) (nativeSeries, error) {
+
+	if hosts == nil {
+		hosts = metricstore.Hosts(cluster)
+	}
+
+	native := make(nativeSeries, len(metrics))
+	topologies := make(map[string]*schema.Topology)
+	for metric, mc := range metrics {
+		for _, host := range hosts {
+			for typeId, series := range metricstore.Read(cluster, host, metric, from, to) {
+				id := -1
+				if mc.Scope != schema.MetricScopeNode {
+					var ok bool
+					if id, ok = nativeTypeId(cluster, host, mc.Scope, typeId, topologies); !ok {
+						continue
+					}
+				}
+
+				if _, ok := native[metric]; !ok {
+					native[metric] = make(map[string]map[int][]schema.Float)
+				}
+				if _, ok := native[metric][host]; !ok {
+					native[metric][host] = make(map[int][]schema.Float)
+				}
+				native[metric][host][id] = alignSeries(series, from)
+			}
+		}
+	}
+
+	return native, nil
+}
+
+// alignSeries returns the data of `series` with a value at `from` (NaN for all values
+// before the first one of the series, which are older than the retention).
+func alignSeries(series metricstore.Series, from int64) []schema.Float {
+	if series.From <= from {
+		return series.Data
+	}
+
+	missing := (series.From - from) / series.Timestep
+	data := make([]schema.Float, missing, missing+int64(len(series.Data)))
+	for i := range data {
+		data[i] = schema.NaN
+	}
+	return append(data, series.Data...)
+}
+
+func (ems *EmbeddedMetricStore) LoadData(
+	job *schema.Job,
+	metrics []string,
+	scopes []schema.MetricScope,
+	ctx context.Context) (schema.JobData, error) {
+
+	return loadNativeJobData("embedded", ems.loadNativeSeries, job, metrics, scopes, ctx)
+}
+
+func (ems *EmbeddedMetricStore) LoadStats(
+	job *schema.Job,
+	metrics []string,
+	ctx context.Context) (map[string]map[string]schema.MetricStatistics, error) {
+
+	return loadNativeStats("embedded", ems.loadNativeSeries, job, metrics, ctx)
+}
+
+func (ems *EmbeddedMetricStore) LoadNodeData(
+	cluster string,
+	metrics, nodes []string,
+	scopes []schema.MetricScope,
+	from, to time.Time,
+	ctx context.Context) (map[string]map[string][]*schema.JobMetric, error) {
+
+	return loadNativeNodeData("embedded", ems.loadNativeSeries, cluster, metrics, nodes, scopes, from, to, ctx)
+}
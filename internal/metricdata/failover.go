@@ -0,0 +1,287 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+const (
+	failoverMinBackoff = 10 * time.Second
+	failoverMaxBackoff = 5 * time.Minute
+)
+
+// A FailoverRepository asks an ordered list of metric data repositories. If one of them fails or
+// does not have any data, the next one is asked. Repositories that failed recently are skipped
+// for some time (growing with the number of consecutive failures), unless all of them failed.
+type FailoverRepository struct {
+	backends []*failoverBackend
+}
+
+type failoverBackend struct {
+	name string
+	repo MetricDataRepository
+
+	lock          sync.Mutex
+	failures      int // Consecutive failures
+	lastError     error
+	lastFailure   time.Time
+	lastSuccess   time.Time
+	lastAnswered  time.Time
+	skipUntil     time.Time
+	answeredCount int
+}
+
+// The health of one repository of a failover chain.
+type BackendHealth struct {
+	Name        string    `json:"name"`
+	Healthy     bool      `json:"healthy"`
+	Failures    int       `json:"failures"`
+	LastError   string    `json:"lastError,omitempty"`
+	LastFailure time.Time `json:"lastFailure,omitempty"`
+	LastSuccess time.Time `json:"lastSuccess,omitempty"`
+
+	// The number of requests answered by this repository and the time of the last one.
+	Answered     int       `json:"answered"`
+	LastAnswered time.Time `json:"lastAnswered,omitempty"`
+}
+
+func (f *FailoverRepository) Init(rawConfig json.RawMessage) error {
+	var configs []json.RawMessage
+	if err := json.Unmarshal(rawConfig, &configs); err != nil {
+		return err
+	}
+	if len(configs) == 0 {
+		return errors.New("failover: at least one metric data repository is required")
+	}
+
+	f.backends = make([]*failoverBackend, 0, len(configs))
+	for i, rawConfig := range configs {
+		var config struct {
+			Kind string `json:"kind"`
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(rawConfig, &config); err != nil {
+			return err
+		}
+
+		repo, err := newMetricDataRepository(rawConfig)
+		if err != nil {
+			return err
+		}
+
+		name := config.Name
+		if name == "" {
+			name = fmt.Sprintf("%s[%d]", config.Kind, i)
+		}
+		f.backends = append(f.backends, &failoverBackend{name: name, repo: repo})
+	}
+
+	return nil
+}
+
+// Health returns the health of all repositories in the order of the chain.
+func (f *FailoverRepository) Health() []BackendHealth {
+	health := make([]BackendHealth, 0, len(f.backends))
+	now := time.Now()
+	for _, b := range f.backends {
+		b.lock.Lock()
+		h := BackendHealth{
+			Name:         b.name,
+			Healthy:      !now.Before(b.skipUntil),
+			Failures:     b.failures,
+			LastFailure:  b.lastFailure,
+			LastSuccess:  b.lastSuccess,
+			Answered:     b.answeredCount,
+			LastAnswered: b.lastAnswered,
+		}
+		if b.lastError != nil {
+			h.LastError = b.lastError.Error()
+		}
+		b.lock.Unlock()
+		health = append(health, h)
+	}
+	return health
+}
+
+// GetRepositoryHealth returns the health of the repositories of every cluster that uses a failover chain.
+func GetRepositoryHealth() map[string][]BackendHealth {
+	health := make(map[string][]BackendHealth)
+	for cluster, repo := range metricDataRepos {
+		if f, ok := repo.(*FailoverRepository); ok {
+			health[cluster] = f.Health()
+		}
+	}
+	return health
+}
+
+func (b *failoverBackend) healthy(now time.Time) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return !now.Before(b.skipUntil)
+}
+
+func (b *failoverBackend) reportFailure(err error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.failures += 1
+	b.lastError = err
+	b.lastFailure = time.Now()
+
+	backoff := failoverMinBackoff
+	for i := 1; i < b.failures && backoff < failoverMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > failoverMaxBackoff {
+		backoff = failoverMaxBackoff
+	}
+	b.skipUntil = b.lastFailure.Add(backoff)
+}
+
+func (b *failoverBackend) reportSuccess(answered bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.failures = 0
+	b.lastSuccess = time.Now()
+	b.skipUntil = time.Time{}
+	if answered {
+		b.answeredCount += 1
+		b.lastAnswered = b.lastSuccess
+	}
+}
+
+// order returns the healthy repositories followed by the ones that failed recently.
+func (f *FailoverRepository) order() []*failoverBackend {
+	now := time.Now()
+	healthy := make([]*failoverBackend, 0, len(f.backends))
+	unhealthy := make([]*failoverBackend, 0)
+	for _, b := range f.backends {
+		if b.healthy(now) {
+			healthy = append(healthy, b)
+		} else {
+			unhealthy = append(unhealthy, b)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+// query calls `load` for the repositories in the chain until one of them returns
+// a non-empty result without an error. If none does, the first non-empty partial
+// result is returned together with its error. `load` returns the size of the result.
+func (f *FailoverRepository) query(what string, ctx context.Context, load func(repo MetricDataRepository) (int, error)) (int, error) {
+	var errs []string
+	partial, partialName, partialErr := -1, "", error(nil)
+	for i, b := range f.order() {
+		if ctx.Err() != nil {
+			break
+		}
+
+		n, err := load(b.repo)
+		switch {
+		case err == nil && n > 0:
+			b.reportSuccess(true)
+			if i == 0 {
+				log.Debugf("failover: %s answered by '%s'", what, b.name)
+			} else {
+				log.Infof("failover: %s answered by '%s' (%s)", what, b.name, strings.Join(errs, ", "))
+			}
+			return i, nil
+		case err == nil:
+			// The repository works, but does not have the data.
+			b.reportSuccess(false)
+			errs = append(errs, fmt.Sprintf("'%s' has no data", b.name))
+		case n > 0:
+			// Partial results still mean that the repository is reachable.
+			b.reportSuccess(false)
+			errs = append(errs, fmt.Sprintf("'%s': %s", b.name, err.Error()))
+			if partial == -1 {
+				partial, partialName, partialErr = i, b.name, fmt.Errorf("failover: partial result from '%s': %w", b.name, err)
+			}
+		default:
+			b.reportFailure(err)
+			errs = append(errs, fmt.Sprintf("'%s': %s", b.name, err.Error()))
+		}
+	}
+
+	if partial != -1 {
+		log.Infof("failover: %s answered (partially) by '%s' (%s)", what, partialName, strings.Join(errs, ", "))
+		return partial, partialErr
+	}
+	if len(errs) == 0 {
+		return -1, ctx.Err()
+	}
+	return -1, fmt.Errorf("failover: no repository could answer: %s", strings.Join(errs, ", "))
+}
+
+func (f *FailoverRepository) LoadData(
+	job *schema.Job,
+	metrics []string,
+	scopes []schema.MetricScope,
+	ctx context.Context) (schema.JobData, error) {
+
+	results := make([]schema.JobData, len(f.backends))
+	i := 0
+	answer, err := f.query(fmt.Sprintf("LoadData(job %d)", job.ID), ctx, func(repo MetricDataRepository) (int, error) {
+		data, err := repo.LoadData(job, metrics, scopes, ctx)
+		results[i] = data
+		i++
+		return len(data), err
+	})
+	if answer == -1 {
+		return nil, err
+	}
+	return results[answer], err
+}
+
+func (f *FailoverRepository) LoadStats(
+	job *schema.Job,
+	metrics []string,
+	ctx context.Context) (map[string]map[string]schema.MetricStatistics, error) {
+
+	results := make([]map[string]map[string]schema.MetricStatistics, len(f.backends))
+	i := 0
+	answer, err := f.query(fmt.Sprintf("LoadStats(job %d)", job.ID), ctx, func(repo MetricDataRepository) (int, error) {
+		stats, err := repo.LoadStats(job, metrics, ctx)
+		results[i] = stats
+		i++
+		return len(stats), err
+	})
+	if answer == -1 {
+		return nil, err
+	}
+	return results[answer], err
+}
+
+func (f *FailoverRepository) LoadNodeData(
+	cluster string,
+	metrics, nodes []string,
+	scopes []schema.MetricScope,
+	from, to time.Time,
+	ctx context.Context) (map[string]map[string][]*schema.JobMetric, error) {
+
+	results := make([]map[string]map[string][]*schema.JobMetric, len(f.backends))
+	i := 0
+	answer, err := f.query(fmt.Sprintf("LoadNodeData(cluster %s)", cluster), ctx, func(repo MetricDataRepository) (int, error) {
+		data, err := repo.LoadNodeData(cluster, metrics, nodes, scopes, from, to, ctx)
+		results[i] = data
+		i++
+		return len(data), err
+	})
+	if answer == -1 {
+		return nil, err
+	}
+	return results[answer], err
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+// A stub repository that fails if err is set and otherwise returns stats for the given node.
+type stubRepository struct {
+	node  string
+	err   error
+	calls int
+}
+
+func (s *stubRepository) Init(_ json.RawMessage) error {
+	return nil
+}
+
+func (s *stubRepository) LoadData(job *schema.Job, metrics []string, scopes []schema.MetricScope, ctx context.Context) (schema.JobData, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.node == "" {
+		return schema.JobData{}, nil
+	}
+	return schema.JobData{"load": {schema.MetricScopeNode: &schema.JobMetric{Series: []schema.Series{{Hostname: s.node}}}}}, nil
+}
+
+func (s *stubRepository) LoadStats(job *schema.Job, metrics []string, ctx context.Context) (map[string]map[string]schema.MetricStatistics, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.node == "" {
+		return map[string]map[string]schema.MetricStatistics{}, nil
+	}
+	return map[string]map[string]schema.MetricStatistics{"load": {s.node: {Avg: 1}}}, nil
+}
+
+func (s *stubRepository) LoadNodeData(cluster string, metrics, nodes []string, scopes []schema.MetricScope, from, to time.Time, ctx context.Context) (map[string]map[string][]*schema.JobMetric, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return map[string]map[string][]*schema.JobMetric{s.node: {}}, nil
+}
+
+func TestFailoverRepository(t *testing.T) {
+	primary := &stubRepository{err: errors.New("connection refused")}
+	empty := &stubRepository{}
+	fallback := &stubRepository{node: "fallback"}
+	f := &FailoverRepository{backends: []*failoverBackend{
+		{name: "primary", repo: primary},
+		{name: "empty", repo: empty},
+		{name: "fallback", repo: fallback},
+	}}
+
+	job := &schema.Job{}
+	stats, err := f.LoadStats(job, []string{"load"}, context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := stats["load"]["fallback"]; !ok {
+		t.Errorf("expected the answer of the fallback, got: %#v", stats)
+	}
+
+	health := f.Health()
+	if health[0].Healthy || health[0].Failures != 1 || health[0].LastError != "connection refused" {
+		t.Errorf("expected the primary to be unhealthy: %#v", health[0])
+	}
+	if !health[1].Healthy || health[1].Answered != 0 || !health[2].Healthy || health[2].Answered != 1 {
+		t.Errorf("unexpected health: %#v", health)
+	}
+	if !health[1].LastAnswered.IsZero() || health[2].LastAnswered.IsZero() {
+		t.Errorf("expected only the fallback to have answered: %#v", health)
+	}
+
+	metricDataRepos["failover"] = f
+	defer delete(metricDataRepos, "failover")
+	if all := GetRepositoryHealth(); len(all["failover"]) != 3 || all["failover"][2].Name != "fallback" {
+		t.Errorf("unexpected health of all clusters: %#v", all)
+	}
+
+	// The unhealthy primary is asked last now.
+	data, err := f.LoadData(job, []string{"load"}, nil, context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data["load"][schema.MetricScopeNode].Series[0].Hostname != "fallback" || primary.calls != 1 {
+		t.Errorf("unexpected answer: %#v (calls of primary: %d)", data, primary.calls)
+	}
+
+	// Once it recovers, the primary is used again.
+	primary.err, primary.node = nil, "primary"
+	f.backends[0].skipUntil = time.Now().Add(-time.Second)
+	nodeData, err := f.LoadNodeData("testcluster", nil, nil, nil, time.Now(), time.Now(), context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := nodeData["primary"]; !ok {
+		t.Errorf("expected the answer of the primary, got: %#v", nodeData)
+	}
+	if health := f.Health(); !health[0].Healthy || health[0].Failures != 0 {
+		t.Errorf("expected the primary to be healthy: %#v", health[0])
+	}
+
+	// All fail:
+	primary.err, fallback.err = errors.New("down"), errors.New("down too")
+	_, err = f.LoadStats(job, []string{"load"}, context.Background())
+	if err == nil || !strings.Contains(err.Error(), "'primary': down") || !strings.Contains(err.Error(), "'fallback': down too") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestFailoverInit(t *testing.T) {
+	mdr, err := newMetricDataRepository(json.RawMessage(`[
+		{"kind": "test", "name": "first", "url": "bla:8081"},
+		{"kind": "test", "url": "bla:8082"}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, ok := mdr.(*FailoverRepository)
+	if !ok || len(f.backends) != 2 || f.backends[0].name != "first" || f.backends[1].name != "test[1]" {
+		t.Fatalf("unexpected repository: %#v", mdr)
+	}
+
+	if _, err := newMetricDataRepository(json.RawMessage(`[{"kind": "unknown", "url": "bla:8081"}]`)); err == nil {
+		t.Errorf("expected an error for an unknown kind")
+	}
+}
@@ -0,0 +1,545 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	protocol "github.com/influxdata/line-protocol"
+)
+
+type FilesDataRepositoryConfig struct {
+	Kind string `json:"kind"`
+
+	// Directory containing the metric files, subdirectories are included.
+	Path string `json:"path"`
+
+	// Unit of the timestamps in the files: "s" (default), "ms", "us" or "ns".
+	Precision string `json:"precision"`
+
+	// Minimum time between two scans of the directory for new or changed files
+	// as parsed by time.ParseDuration (default: "1m").
+	Refresh string `json:"refresh"`
+}
+
+// FilesDataRepository serves metric data from a directory tree of files, e.g. dumps shipped
+// from clusters without network access. The format of a file is chosen by its extension:
+//
+//   - `.lp`: InfluxDB line protocol like written by the cc-metric-collector: The measurement
+//     is the metric, the value is in the field `value`, the tags `hostname`, `type`, `type-id`
+//     and `cluster` (optional) are used.
+//   - `.csv`: The first line names the columns, known are `timestamp`, `hostname`, `metric`,
+//     `value`, `type`, `type-id` and `cluster`. Without a `metric` column, every unknown column
+//     is a metric. Without a `hostname` column, the name of the directory containing the
+//     file is the hostname (e.g. `<path>/<hostname>/2022-10-01.csv`). Timestamps can also
+//     be given in RFC3339 format.
+//
+// Every file is indexed by the time range and hosts it contains so that only the relevant
+// files are read. Samples have to be in the native scope of their metric (the scope from the
+// cluster.json), coarser scopes are aggregated using the topology of the node.
+type FilesDataRepository struct {
+	path      string
+	precision time.Duration
+	refresh   time.Duration
+
+	lock     sync.Mutex // Protects the fields below, not held while scanning
+	scanning bool
+	scanned  time.Time
+	index    map[string]*metricFile
+}
+
+// The index entry of a file.
+type metricFile struct {
+	path     string
+	modTime  time.Time
+	size     int64
+	from, to int64 // Unix timestamps of the first and last sample
+	hosts    map[string]struct{}
+}
+
+// A single value read from a file.
+type fileSample struct {
+	metric, hostname, cluster string
+	typ, typeId               string
+	timestamp                 int64
+	value                     float64
+}
+
+func (f *FilesDataRepository) Init(rawConfig json.RawMessage) error {
+	var config FilesDataRepositoryConfig
+	if err := json.Unmarshal(rawConfig, &config); err != nil {
+		return err
+	}
+
+	if config.Path == "" {
+		return errors.New("files: the path is required")
+	}
+	if info, err := os.Stat(config.Path); err != nil {
+		return fmt.Errorf("files: %w", err)
+	} else if !info.IsDir() {
+		return fmt.Errorf("files: '%s' is not a directory", config.Path)
+	}
+	f.path = config.Path
+
+	switch config.Precision {
+	case "", "s":
+		f.precision = time.Second
+	case "ms":
+		f.precision = time.Millisecond
+	case "us":
+		f.precision = time.Microsecond
+	case "ns":
+		f.precision = time.Nanosecond
+	default:
+		return fmt.Errorf("files: invalid precision '%s'", config.Precision)
+	}
+
+	f.refresh = time.Minute
+	if config.Refresh != "" {
+		d, err := time.ParseDuration(config.Refresh)
+		if err != nil {
+			return fmt.Errorf("files: invalid refresh interval: %w", err)
+		}
+		f.refresh = d
+	}
+
+	index, err := f.scan(nil)
+	if err != nil {
+		return err
+	}
+	f.index, f.scanned = index, time.Now()
+	return nil
+}
+
+// scan builds a new index, only new or changed files are read, the entries
+// of unchanged files are taken from `old`. The lock is not held while
+// scanning, the index map is never modified after it was built.
+func (f *FilesDataRepository) scan(old map[string]*metricFile) (map[string]*metricFile, error) {
+	index := make(map[string]*metricFile, len(old))
+	err := filepath.WalkDir(f.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !isMetricFile(path) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		if mf, ok := old[path]; ok && mf.modTime.Equal(info.ModTime()) && mf.size == info.Size() {
+			index[path] = mf
+			return nil
+		}
+
+		mf := &metricFile{
+			path:    path,
+			modTime: info.ModTime(),
+			size:    info.Size(),
+			from:    math.MaxInt64,
+			to:      math.MinInt64,
+			hosts:   make(map[string]struct{}),
+		}
+		if err := f.readFile(path, func(s *fileSample) {
+			if s.timestamp < mf.from {
+				mf.from = s.timestamp
+			}
+			if s.timestamp > mf.to {
+				mf.to = s.timestamp
+			}
+			mf.hosts[s.hostname] = struct{}{}
+		}); err != nil {
+			log.Warnf("files: skipping '%s': %s", path, err.Error())
+			return nil
+		}
+
+		index[path] = mf
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("files: scanning '%s' failed: %w", f.path, err)
+	}
+
+	return index, nil
+}
+
+// rescan replaces the index if it is older than the refresh interval. Only one
+// rescan runs at a time, queries meanwhile use the previous index.
+func (f *FilesDataRepository) rescan() error {
+	f.lock.Lock()
+	if f.scanning || time.Since(f.scanned) < f.refresh {
+		f.lock.Unlock()
+		return nil
+	}
+	f.scanning = true
+	old := f.index
+	f.lock.Unlock()
+
+	index, err := f.scan(old)
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.scanning = false
+	if err != nil {
+		return err
+	}
+	f.index, f.scanned = index, time.Now()
+	return nil
+}
+
+// files returns the files with samples from `from` to `to` of at least one of the hosts (of any if `hosts` is nil).
+func (f *FilesDataRepository) files(hosts []string, from, to int64) ([]string, error) {
+	if err := f.rescan(); err != nil {
+		return nil, err
+	}
+
+	f.lock.Lock()
+	index := f.index
+	f.lock.Unlock()
+
+	files := make([]string, 0)
+	for path, mf := range index {
+		if mf.to < from || mf.from > to {
+			continue
+		}
+
+		matches := hosts == nil
+		for _, host := range hosts {
+			if _, ok := mf.hosts[host]; ok {
+				matches = true
+				break
+			}
+		}
+		if matches {
+			files = append(files, path)
+		}
+	}
+
+	return files, nil
+}
+
+func isMetricFile(path string) bool {
+	ext := filepath.Ext(path)
+	return ext == ".lp" || ext == ".csv"
+}
+
+// readFile calls `fn` for every sample in the file. The sample passed to `fn` is reused.
+func (f *FilesDataRepository) readFile(path string, fn func(s *fileSample)) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if filepath.Ext(path) == ".csv" {
+		return f.readCSV(file, filepath.Base(filepath.Dir(path)), fn)
+	}
+	return f.readLineProtocol(file, fn)
+}
+
+func (f *FilesDataRepository) readLineProtocol(r io.Reader, fn func(s *fileSample)) error {
+	parser := protocol.NewStreamParser(r)
+	parser.SetTimePrecision(f.precision)
+	// Lines without a timestamp are skipped:
+	parser.SetTimeFunc(func() time.Time { return time.Time{} })
+
+	s := &fileSample{}
+	for {
+		m, err := parser.Next()
+		if err == protocol.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if m.Time().IsZero() {
+			continue
+		}
+
+		*s = fileSample{metric: m.Name(), timestamp: m.Time().Unix()}
+		for _, tag := range m.TagList() {
+			switch tag.Key {
+			case "hostname":
+				s.hostname = tag.Value
+			case "cluster":
+				s.cluster = tag.Value
+			case "type":
+				s.typ = tag.Value
+			case "type-id":
+				s.typeId = tag.Value
+			}
+		}
+
+		value, ok := 0.0, false
+		for _, field := range m.FieldList() {
+			if field.Key != "value" {
+				continue
+			}
+			switch x := field.Value.(type) {
+			case float64:
+				value, ok = x, true
+			case int64:
+				value, ok = float64(x), true
+			case uint64:
+				value, ok = float64(x), true
+			}
+		}
+		if !ok || s.hostname == "" {
+			continue
+		}
+
+		s.value = value
+		fn(s)
+	}
+}
+
+func (f *FilesDataRepository) readCSV(r io.Reader, dirname string, fn func(s *fileSample)) error {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	columns := map[string]int{}
+	metricColumns := map[int]string{}
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		switch name {
+		case "timestamp", "hostname", "metric", "value", "type", "type-id", "cluster":
+			columns[name] = i
+		default:
+			metricColumns[i] = name
+		}
+	}
+
+	tsColumn, ok := columns["timestamp"]
+	if !ok {
+		return errors.New("no timestamp column")
+	}
+	if _, ok := columns["metric"]; ok {
+		if _, ok := columns["value"]; !ok {
+			return errors.New("no value column")
+		}
+		metricColumns = map[int]string{columns["value"]: ""}
+	}
+
+	column := func(record []string, name string) string {
+		if i, ok := columns[name]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	s := &fileSample{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		ts, err := f.parseTimestamp(strings.TrimSpace(record[tsColumn]))
+		if err != nil {
+			line, _ := reader.FieldPos(tsColumn)
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+
+		*s = fileSample{
+			hostname:  column(record, "hostname"),
+			cluster:   column(record, "cluster"),
+			typ:       column(record, "type"),
+			typeId:    column(record, "type-id"),
+			timestamp: ts,
+		}
+		if _, ok := columns["hostname"]; !ok {
+			s.hostname = dirname
+		}
+
+		for i, metric := range metricColumns {
+			if metric == "" {
+				metric = column(record, "metric")
+			}
+
+			value, err := strconv.ParseFloat(strings.TrimSpace(record[i]), 64)
+			if err != nil {
+				// Missing values are allowed.
+				continue
+			}
+
+			s.metric, s.value = metric, value
+			fn(s)
+		}
+	}
+}
+
+func (f *FilesDataRepository) parseTimestamp(str string) (int64, error) {
+	if x, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return time.Unix(0, x*int64(f.precision)).Unix(), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, str)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timestamp '%s'", str)
+	}
+	return t.Unix(), nil
+}
+
+// loadNativeSeries reads the samples of the given metrics from `from` to `to`. Samples within
+// the same interval are averaged.
+func (f *FilesDataRepository) loadNativeSeries(
+	ctx context.Context,
+	cluster string,
+	metrics map[string]*schema.MetricConfig,
+	hosts []string,
+	from, to int64) (nativeSeries, error) {
+
+	if to < from {
+		to = from
+	}
+
+	files, err := f.files(hosts, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var hostSet map[string]bool
+	if hosts != nil {
+		hostSet = make(map[string]bool, len(hosts))
+		for _, host := range hosts {
+			hostSet[host] = true
+		}
+	}
+
+	sums := make(nativeSeries)
+	counts := make(map[string]map[string]map[int][]int)
+	topologies := make(map[string]*schema.Topology)
+	for _, path := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		err := f.readFile(path, func(s *fileSample) {
+			mc, ok := metrics[s.metric]
+			if !ok || s.timestamp < from || s.timestamp > to ||
+				(hostSet != nil && !hostSet[s.hostname]) ||
+				(s.cluster != "" && s.cluster != cluster) {
+				return
+			}
+
+			typeId := -1
+			if mc.Scope == schema.MetricScopeNode {
+				if s.typ != "" && s.typ != string(schema.MetricScopeNode) {
+					return
+				}
+			} else {
+				if s.typ != string(mc.Scope) {
+					return
+				}
+
+				id, ok := nativeTypeId(cluster, s.hostname, mc.Scope, s.typeId, topologies)
+				if !ok {
+					return
+				}
+				typeId = id
+			}
+
+			timestep := int64(mc.Timestep)
+			if timestep <= 0 {
+				timestep = 60
+			}
+			i := int(math.Round(float64(s.timestamp-from) / float64(timestep)))
+
+			if _, ok := sums[s.metric]; !ok {
+				sums[s.metric] = make(map[string]map[int][]schema.Float)
+				counts[s.metric] = make(map[string]map[int][]int)
+			}
+			if _, ok := sums[s.metric][s.hostname]; !ok {
+				sums[s.metric][s.hostname] = make(map[int][]schema.Float)
+				counts[s.metric][s.hostname] = make(map[int][]int)
+			}
+			sum, ok := sums[s.metric][s.hostname][typeId]
+			if !ok {
+				sum = make([]schema.Float, int((to-from)/timestep)+1)
+				counts[s.metric][s.hostname][typeId] = make([]int, len(sum))
+				sums[s.metric][s.hostname][typeId] = sum
+			}
+			if i >= len(sum) {
+				return
+			}
+
+			sum[i] += schema.Float(s.value)
+			counts[s.metric][s.hostname][typeId][i] += 1
+		})
+		if err != nil {
+			return nil, fmt.Errorf("files: reading '%s' failed: %w", path, err)
+		}
+	}
+
+	for metric, byHost := range sums {
+		for host, byId := range byHost {
+			for typeId, data := range byId {
+				count := counts[metric][host][typeId]
+				for i := range data {
+					if count[i] == 0 {
+						data[i] = schema.NaN
+					} else {
+						data[i] /= schema.Float(count[i])
+					}
+				}
+			}
+		}
+	}
+
+	return sums, nil
+}
+
+func (f *FilesDataRepository) LoadData(
+	job *schema.Job,
+	metrics []string,
+	scopes []schema.MetricScope,
+	ctx context.Context) (schema.JobData, error) {
+
+	return loadNativeJobData("files", f.loadNativeSeries, job, metrics, scopes, ctx)
+}
+
+func (f *FilesDataRepository) LoadStats(
+	job *schema.Job,
+	metrics []string,
+	ctx context.Context) (map[string]map[string]schema.MetricStatistics, error) {
+
+	return loadNativeStats("files", f.loadNativeSeries, job, metrics, ctx)
+}
+
+func (f *FilesDataRepository) LoadNodeData(
+	cluster string,
+	metrics, nodes []string,
+	scopes []schema.MetricScope,
+	from, to time.Time,
+	ctx context.Context) (map[string]map[string][]*schema.JobMetric, error) {
+
+	return loadNativeNodeData("files", f.loadNativeSeries, cluster, metrics, nodes, scopes, from, to, ctx)
+}
@@ -0,0 +1,203 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+// setupFiles writes line protocol with cpu_load of the hwthreads 0, 1 and 20 (values 1, 4 and 2)
+// of e0101 and e0102 and a per node CSV file with mem_used of e0101 (values 0, 1, 2, ...).
+// The first sample of hwthread 1 is missing.
+func setupFiles(t *testing.T, start int64) *FilesDataRepository {
+	if err := archive.Init(json.RawMessage(`{"kind": "file","path": "../../test/archive"}`), false); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	lp := &strings.Builder{}
+	csv := &strings.Builder{}
+	fmt.Fprintln(csv, "timestamp,mem_used")
+	for i := int64(0); i < 5; i++ {
+		ts := start + i*60
+		for _, host := range []string{"e0101", "e0102"} {
+			fmt.Fprintf(lp, "cpu_load,cluster=emmy,hostname=%s,type=hwthread,type-id=0 value=1 %d\n", host, ts)
+			fmt.Fprintf(lp, "cpu_load,cluster=emmy,hostname=%s,type=hwthread,type-id=20 value=2 %d\n", host, ts)
+			if i > 0 {
+				fmt.Fprintf(lp, "cpu_load,cluster=emmy,hostname=%s,type=hwthread,type-id=1 value=4 %d\n", host, ts)
+			}
+		}
+		// Other clusters are ignored:
+		fmt.Fprintf(lp, "cpu_load,cluster=fritz,hostname=e0101,type=hwthread,type-id=0 value=100 %d\n", ts)
+		fmt.Fprintf(csv, "%d,%d\n", ts, i)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "dump.lp"), []byte(lp.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "e0101"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "e0101", "2020-09-13.csv"), []byte(csv.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// A file from another day that should never be read:
+	if err := os.WriteFile(filepath.Join(dir, "e0101", "2020-09-14.csv"),
+		[]byte(fmt.Sprintf("timestamp,hostname,metric,value\n%d,e0101,mem_used,100\n", start+86400)), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	f := &FilesDataRepository{}
+	if err := f.Init(json.RawMessage(`{"kind": "files", "path": "` + dir + `"}`)); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func TestFilesLoadData(t *testing.T) {
+	start := int64(1600000000)
+	f := setupFiles(t, start)
+
+	if len(f.index) != 3 {
+		t.Fatalf("expected three indexed files, got: %#v", f.index)
+	}
+	if files, err := f.files([]string{"e0101"}, start, start+240); err != nil || len(files) != 2 {
+		t.Fatalf("expected two files, got: %#v (%v)", files, err)
+	}
+
+	job := &schema.Job{BaseJob: schema.BaseJob{
+		Cluster:    "emmy",
+		SubCluster: "main",
+		NumNodes:   2,
+		Resources: []*schema.Resource{
+			{Hostname: "e0101"},
+			{Hostname: "e0102", HWThreads: []int{1}},
+		},
+	}}
+	job.StartTime = time.Unix(start, 0)
+	job.Duration = 180
+
+	scopes := []schema.MetricScope{schema.MetricScopeNode, schema.MetricScopeCore, schema.MetricScopeHWThread}
+	jobData, err := f.LoadData(job, []string{"cpu_load", "mem_used"}, scopes, context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	node := jobData["cpu_load"][schema.MetricScopeNode]
+	if node == nil || len(node.Series) != 2 || node.Timestep != 60 {
+		t.Fatalf("unexpected node scope data: %#v", node)
+	}
+	if s := node.Series[0]; s.Hostname != "e0101" || len(s.Data) != 4 || s.Data[0] != 3 || s.Data[1] != 7 {
+		t.Errorf("unexpected series: %#v", s)
+	}
+	// e0102 only has hwthread 1 assigned.
+	if s := node.Series[1]; s.Hostname != "e0102" || !s.Data[0].IsNaN() || s.Data[3] != 4 || s.Statistics.Avg != 4 {
+		t.Errorf("unexpected series: %#v", s)
+	}
+
+	core := jobData["cpu_load"][schema.MetricScopeCore]
+	if core == nil || len(core.Series) != 3 {
+		t.Fatalf("unexpected core scope data: %#v", core)
+	}
+	if s := core.Series[0]; s.Hostname != "e0101" || *s.Id != 0 || s.Data[1] != 3 {
+		t.Errorf("unexpected series: %#v", s)
+	}
+
+	hwthread := jobData["cpu_load"][schema.MetricScopeHWThread]
+	if hwthread == nil || len(hwthread.Series) != 4 {
+		t.Fatalf("unexpected hwthread scope data: %#v", hwthread)
+	}
+
+	// mem_used only has node scope and only data for e0101.
+	if _, ok := jobData["mem_used"][schema.MetricScopeCore]; ok {
+		t.Errorf("expected no core scope data for mem_used")
+	}
+	memUsed := jobData["mem_used"][schema.MetricScopeNode]
+	if memUsed == nil || len(memUsed.Series) != 1 || memUsed.Series[0].Data[3] != 3 || memUsed.Series[0].Statistics.Max != 3 {
+		t.Fatalf("unexpected mem_used data: %#v", memUsed)
+	}
+
+	stats, err := f.LoadStats(job, []string{"cpu_load"}, context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, ok := stats["cpu_load"]["e0101"]; !ok || s.Min != 3 || s.Max != 7 {
+		t.Errorf("unexpected stats: %#v", stats)
+	}
+}
+
+func TestFilesLoadNodeData(t *testing.T) {
+	start := int64(1600000000)
+	f := setupFiles(t, start)
+
+	from := time.Unix(start, 0)
+	to := from.Add(2 * time.Minute)
+	data, err := f.LoadNodeData("emmy", []string{"cpu_load", "mem_used"}, nil,
+		[]schema.MetricScope{schema.MetricScopeSocket}, from, to, context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 2 {
+		t.Fatalf("expected data for two nodes, got: %#v", data)
+	}
+
+	// hwthreads 0, 1 and 20 are all on socket 0, mem_used has no socket scope.
+	metrics := data["e0102"]["cpu_load"]
+	if len(metrics) != 1 || metrics[0].Scope != schema.MetricScopeSocket || len(metrics[0].Series) != 1 {
+		t.Fatalf("unexpected data: %#v", metrics)
+	}
+	if s := metrics[0].Series[0]; len(s.Data) != 3 || *s.Id != 0 || s.Data[0] != 3 || s.Data[2] != 7 {
+		t.Errorf("unexpected series: %#v", s)
+	}
+	if _, ok := data["e0101"]["mem_used"]; ok {
+		t.Errorf("expected no data for mem_used")
+	}
+}
+
+func TestFilesRescan(t *testing.T) {
+	start := int64(1600000000)
+	f := setupFiles(t, start)
+	f.refresh = 0
+
+	old := f.index
+	path := filepath.Join(f.path, "e0103.lp")
+	if err := os.WriteFile(path,
+		[]byte(fmt.Sprintf("cpu_load,cluster=emmy,hostname=e0103,type=hwthread,type-id=0 value=1 %d\n", start)), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := f.files([]string{"e0103"}, start, start+60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0] != path {
+		t.Fatalf("expected the new file, got: %#v", files)
+	}
+	// The previous index is replaced, not modified, so that queries can keep using it.
+	if _, ok := old[path]; ok || len(old) != 3 {
+		t.Errorf("previous index was modified: %#v", old)
+	}
+
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	files, err = f.files([]string{"e0103"}, start, start+60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("expected no files, got: %#v", files)
+	}
+}
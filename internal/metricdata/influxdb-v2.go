@@ -11,6 +11,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"math"
+	"strconv"
 	"strings"
 	"time"
 
@@ -311,6 +313,12 @@ func (idb *InfluxDBv2DataRepository) LoadStats(
 	return stats, nil
 }
 
+// LoadNodeData is used in the system and node views. Every metric is fetched in its native scope
+// (the scope from the cluster config) and is then aggregated to the requested scopes using the
+// metrics aggregation (sum by default) and the topology of the subcluster of each node. Scopes
+// finer than the native scope of a metric are skipped. Like with the cc-metric-store, errors
+// for individual metrics do not abort the query: the data that could be loaded is returned
+// together with an error describing what failed.
 func (idb *InfluxDBv2DataRepository) LoadNodeData(
 	cluster string,
 	metrics, nodes []string,
@@ -318,8 +326,160 @@ func (idb *InfluxDBv2DataRepository) LoadNodeData(
 	from, to time.Time,
 	ctx context.Context) (map[string]map[string][]*schema.JobMetric, error) {
 
-	// TODO : Implement to be used in Analysis- und System/Node-View
-	log.Println(fmt.Sprintf("LoadNodeData unimplemented for InfluxDBv2DataRepository, Args: cluster %s, metrics %v, nodes %v, scopes %v", cluster, metrics, nodes, scopes))
+	data := make(map[string]map[string][]*schema.JobMetric)
+	hostsCond := "true"
+	if nodes != nil {
+		if len(nodes) == 0 {
+			return data, nil
+		}
+
+		hostsConds := make([]string, 0, len(nodes))
+		for _, node := range nodes {
+			hostsConds = append(hostsConds, `r["hostname"] == `+fluxString(node))
+		}
+		hostsCond = strings.Join(hostsConds, " or ")
+	}
+
+	var errs []string
+	for _, metric := range metrics {
+		mc := archive.GetMetricConfig(cluster, metric)
+		if mc == nil {
+			errs = append(errs, fmt.Sprintf("metric %s is not configured for cluster %s", metric, cluster))
+			continue
+		}
+
+		series, err := idb.loadNativeSeries(ctx, cluster, metric, mc, hostsCond, from, to)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("fetching %s failed: %s", metric, err.Error()))
+			continue
+		}
+
+		for host, hostSeries := range series {
+			for _, scope := range scopes {
+				if !canAggregate(mc.Scope, scope) {
+					continue
+				}
+
+				scoped, err := aggregateSeries(cluster, host, mc, hostSeries, scope)
+				if err != nil {
+					errs = append(errs, fmt.Sprintf("fetching %s for node %s failed: %s", metric, host, err.Error()))
+					continue
+				}
+
+				hostdata, ok := data[host]
+				if !ok {
+					hostdata = make(map[string][]*schema.JobMetric)
+					data[host] = hostdata
+				}
+
+				hostdata[metric] = append(hostdata[metric], &schema.JobMetric{
+					Unit:     mc.Unit,
+					Scope:    scope,
+					Timestep: mc.Timestep,
+					Series:   scoped,
+				})
+			}
+		}
+	}
+
+	if len(errs) != 0 {
+		return data, fmt.Errorf("influxdb-v2: %s", strings.Join(errs, ", "))
+	}
+
+	return data, nil
+}
+
+// loadNativeSeries returns the series of a metric in its native scope, keyed by hostname and type-id.
+// Node level series use the type-id -1. Missing values are NaN.
+func (idb *InfluxDBv2DataRepository) loadNativeSeries(
+	ctx context.Context,
+	cluster, metric string,
+	mc *schema.MetricConfig,
+	hostsCond string,
+	from, to time.Time) (map[string]map[int][]schema.Float, error) {
+
+	typeCond := `(not exists r["type"] or r["type"] == "node")`
+	if mc.Scope != schema.MetricScopeNode {
+		typeCond = `r["type"] == ` + fluxString(string(mc.Scope))
+	}
 
-	return nil, errors.New("unimplemented for InfluxDBv2DataRepository")
+	timestep := mc.Timestep
+	if timestep <= 0 {
+		timestep = 60
+	}
+
+	query := fmt.Sprintf(`
+				from(bucket: %s)
+				|> range(start: %s, stop: %s)
+				|> filter(fn: (r) => r["_measurement"] == %s and r["_field"] == "value" and r["cluster"] == %s)
+				|> filter(fn: (r) => (%s) and %s)
+				|> group(columns: ["hostname", "type-id"])
+				|> aggregateWindow(every: %ds, fn: mean, createEmpty: true)`,
+		fluxString(idb.bucket),
+		idb.formatTime(from), idb.formatTime(to),
+		fluxString(metric), fluxString(cluster), hostsCond, typeCond, timestep)
+
+	rows, err := idb.queryClient.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	series := make(map[string]map[int][]schema.Float)
+	for rows.Next() {
+		row := rows.Record()
+		host, ok := row.ValueByKey("hostname").(string)
+		if !ok {
+			continue
+		}
+
+		typeId := -1
+		if mc.Scope != schema.MetricScopeNode {
+			str, _ := row.ValueByKey("type-id").(string)
+			if typeId, err = strconv.Atoi(str); err != nil {
+				return nil, fmt.Errorf("invalid type-id %#v for node %s", str, host)
+			}
+		}
+
+		hostSeries, ok := series[host]
+		if !ok {
+			hostSeries = make(map[int][]schema.Float)
+			series[host] = hostSeries
+		}
+
+		if val, ok := row.Value().(float64); ok {
+			hostSeries[typeId] = append(hostSeries[typeId], schema.Float(val))
+		} else {
+			hostSeries[typeId] = append(hostSeries[typeId], schema.NaN)
+		}
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return series, nil
+}
+
+// fluxString returns `str` as Flux string literal. Backslashes, quotes and the start of an
+// interpolation (`${`) are escaped.
+func fluxString(str string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "${", `\${`).Replace(str) + `"`
+}
+
+// seriesStatistics calculates min/avg/max of all values that are not NaN.
+func seriesStatistics(data []schema.Float) *schema.MetricStatistics {
+	min, max, sum, n := math.MaxFloat64, -math.MaxFloat64, 0.0, 0
+	for _, x := range data {
+		if x.IsNaN() {
+			continue
+		}
+		min, max = math.Min(min, float64(x)), math.Max(max, float64(x))
+		sum += float64(x)
+		n += 1
+	}
+
+	if n == 0 {
+		return &schema.MetricStatistics{Avg: 0., Min: 0., Max: 0.}
+	}
+	return &schema.MetricStatistics{Avg: sum / float64(n), Min: min, Max: max}
 }
@@ -0,0 +1,142 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+var fluxHostname = regexp.MustCompile(`r\["hostname"\] == "((?:[^"\\]|\\.)*)"`)
+
+// A fake InfluxDB server: Queries for cpu_load return two hwthreads per requested node (all nodes
+// if no hostname is filtered), queries for mem_used one node level series, everything else fails.
+// The values are 1, 2 and 3, the second one is missing. All queries are appended to `queries`.
+func fakeInfluxDB(t *testing.T, queries *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query string `json:"query"`
+		}
+		if r.URL.Path != "/api/v2/query" || json.NewDecoder(r.Body).Decode(&body) != nil {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+		*queries = append(*queries, body.Query)
+
+		ids := []string{""}
+		if strings.Contains(body.Query, `r["_measurement"] == "cpu_load"`) {
+			ids = []string{"0", "1"}
+		} else if !strings.Contains(body.Query, `r["_measurement"] == "mem_used"`) {
+			rw.Header().Set("Content-Type", "application/json")
+			rw.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(rw, `{"code":"invalid","message":"unknown metric"}`)
+			return
+		}
+
+		hosts := []string{"e0101", "e0102"}
+		if matches := fluxHostname.FindAllStringSubmatch(body.Query, -1); matches != nil {
+			hosts = hosts[:0]
+			for _, m := range matches {
+				hosts = append(hosts, strings.NewReplacer(`\"`, `"`, `\\`, `\`).Replace(m[1]))
+			}
+		}
+
+		rw.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		fmt.Fprint(rw, "#datatype,string,long,dateTime:RFC3339,double,string,string\r\n"+
+			"#group,false,false,false,false,true,true\r\n"+
+			"#default,_result,,,,,\r\n"+
+			",result,table,_time,_value,hostname,type-id\r\n")
+		table := 0
+		for _, host := range hosts {
+			for _, id := range ids {
+				for i, value := range []string{"1", "", "3"} {
+					ts := time.Unix(1600000000+int64(i)*60, 0).UTC().Format(time.RFC3339)
+					fmt.Fprintf(rw, ",,%d,%s,%s,\"%s\",%s\r\n", table, ts, value, strings.ReplaceAll(host, `"`, `""`), id)
+				}
+				table += 1
+			}
+		}
+	}))
+}
+
+func TestInfluxDBLoadNodeData(t *testing.T) {
+	if err := archive.Init(json.RawMessage(`{"kind": "file","path": "../../test/archive"}`), false); err != nil {
+		t.Fatal(err)
+	}
+
+	queries := []string{}
+	server := fakeInfluxDB(t, &queries)
+	defer server.Close()
+
+	idb := &InfluxDBv2DataRepository{}
+	if err := idb.Init(json.RawMessage(`{"url": "` + server.URL + `", "token": "secret", "bucket": "test", "org": "test"}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	from := time.Unix(1600000000, 0)
+	to := from.Add(3 * time.Minute)
+	scopes := []schema.MetricScope{schema.MetricScopeNode, schema.MetricScopeHWThread}
+	data, err := idb.LoadNodeData("emmy", []string{"cpu_load", "mem_used", "flops_any"}, []string{"e0101", `e01"02`},
+		scopes, from, to, context.Background())
+
+	// flops_any fails, the other metrics should still be returned.
+	if err == nil || !strings.Contains(err.Error(), "fetching flops_any failed") {
+		t.Errorf("expected a partial error, got: %v", err)
+	}
+	if len(data) != 2 {
+		t.Fatalf("expected data for two nodes, got: %#v", data)
+	}
+
+	// The hostname is escaped and passed on unchanged.
+	if !strings.Contains(queries[0], `r["hostname"] == "e01\"02"`) {
+		t.Errorf("hostname not escaped: %s", queries[0])
+	}
+
+	if str := fluxString(`a${b}\`); str != `"a\${b}\\"` {
+		t.Errorf("unexpected escaping: %s", str)
+	}
+
+	metrics := data["e0101"]["cpu_load"]
+	if len(metrics) != 2 || metrics[0].Scope != schema.MetricScopeNode || metrics[1].Scope != schema.MetricScopeHWThread {
+		t.Fatalf("unexpected data: %#v", metrics)
+	}
+	if s := metrics[0].Series[0]; len(s.Data) != 3 || s.Data[0] != 2 || !s.Data[1].IsNaN() || s.Data[2] != 6 {
+		t.Errorf("unexpected node series: %#v", s)
+	}
+	if len(metrics[1].Series) != 2 || *metrics[1].Series[1].Id != 1 || metrics[1].Series[1].Statistics.Avg != 2 {
+		t.Errorf("unexpected hwthread series: %#v", metrics[1].Series)
+	}
+
+	// mem_used has no hwthread scope.
+	if metrics := data[`e01"02`]["mem_used"]; len(metrics) != 1 || metrics[0].Scope != schema.MetricScopeNode {
+		t.Errorf("unexpected data: %#v", metrics)
+	}
+
+	// No nodes, no data (and no query):
+	numQueries := len(queries)
+	if data, err := idb.LoadNodeData("emmy", []string{"cpu_load"}, []string{}, scopes, from, to, context.Background()); err != nil || len(data) != 0 {
+		t.Errorf("expected no data for an empty list of nodes, got: %#v, %v", data, err)
+	}
+	if len(queries) != numQueries {
+		t.Errorf("expected no query for an empty list of nodes")
+	}
+
+	if _, err := idb.LoadNodeData("emmy", []string{"mem_used"}, nil, scopes, from, to, context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if q := queries[len(queries)-1]; !strings.Contains(q, `(true)`) {
+		t.Errorf("expected no hostname filter without nodes: %s", q)
+	}
+}
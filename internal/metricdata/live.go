@@ -0,0 +1,339 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+const (
+	// Updates a subscriber can be behind before it is dropped.
+	liveSubscriberBuffer = 8
+
+	// Timeout for loading the new data of a job.
+	livePollTimeout = 30 * time.Second
+)
+
+// A LiveUpdate contains the data points of one metric of a running job that became
+// available since the previous update.
+type LiveUpdate struct {
+	Metric   string
+	Unit     string
+	Scope    schema.MetricScope
+	Timestep int
+	Offset   int // Index of the first new data point in the series of the whole job
+	Series   []schema.Series
+}
+
+type liveSubscriber struct {
+	metrics map[string]bool
+	scopes  map[schema.MetricScope]bool
+	updates chan []*LiveUpdate
+}
+
+// A livePoller loads the new data of a running job for all subscribers of that job.
+type livePoller struct {
+	job *schema.Job
+
+	lock        sync.Mutex
+	subscribers map[*liveSubscriber]bool
+	metrics     map[string]*liveMetric
+}
+
+type liveMetric struct {
+	timestep time.Duration
+	next     time.Time // The time of the next data point
+}
+
+var (
+	livePollers     map[int64]*livePoller = map[int64]*livePoller{}
+	livePollersLock sync.Mutex
+)
+
+// SubscribeJob returns a channel receiving the new data points of `metrics` (all metrics if nil)
+// of a running job every timestep of the metrics. All subscribers of a job share the requests to
+// the metric data repository. The channel is closed if `ctx` is done or if the subscriber does not
+// keep up with the updates.
+func SubscribeJob(
+	ctx context.Context,
+	job *schema.Job,
+	metrics []string,
+	scopes []schema.MetricScope) (<-chan []*LiveUpdate, error) {
+
+	if job.State != schema.JobStateRunning {
+		return nil, errors.New("the job is not running")
+	}
+	if _, ok := metricDataRepos[job.Cluster]; !ok {
+		return nil, fmt.Errorf("no metric data repository configured for '%s'", job.Cluster)
+	}
+
+	if metrics == nil {
+		for _, mc := range archive.GetCluster(job.Cluster).MetricConfig {
+			metrics = append(metrics, mc.Name)
+		}
+	}
+	if scopes == nil {
+		scopes = append(scopes, schema.MetricScopeNode)
+	}
+
+	sub := &liveSubscriber{
+		metrics: make(map[string]bool, len(metrics)),
+		scopes:  make(map[schema.MetricScope]bool, len(scopes)),
+		updates: make(chan []*LiveUpdate, liveSubscriberBuffer),
+	}
+	for _, metric := range metrics {
+		if archive.GetMetricConfig(job.Cluster, metric) == nil {
+			return nil, fmt.Errorf("unknown metric '%s'", metric)
+		}
+		sub.metrics[metric] = true
+	}
+	for _, scope := range scopes {
+		sub.scopes[scope] = true
+	}
+
+	livePollersLock.Lock()
+	p, ok := livePollers[job.ID]
+	if !ok {
+		p = &livePoller{
+			job:         job,
+			subscribers: map[*liveSubscriber]bool{},
+			metrics:     map[string]*liveMetric{},
+		}
+		livePollers[job.ID] = p
+		go p.run()
+	}
+	p.subscribe(sub)
+	livePollersLock.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		p.unsubscribe(sub)
+	}()
+
+	return sub.updates, nil
+}
+
+// subscribe adds a subscriber, metrics not loaded so far start with the latest data point.
+func (p *livePoller) subscribe(sub *liveSubscriber) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := time.Now()
+	for metric := range sub.metrics {
+		if _, ok := p.metrics[metric]; ok {
+			continue
+		}
+
+		timestep := time.Duration(archive.GetMetricConfig(p.job.Cluster, metric).Timestep) * time.Second
+		if timestep <= 0 {
+			timestep = 60 * time.Second
+		}
+		p.metrics[metric] = &liveMetric{
+			timestep: timestep,
+			next:     p.job.StartTime.Add(now.Sub(p.job.StartTime).Truncate(timestep)),
+		}
+	}
+	p.subscribers[sub] = true
+}
+
+func (p *livePoller) unsubscribe(sub *liveSubscriber) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.subscribers[sub] {
+		delete(p.subscribers, sub)
+		close(sub.updates)
+	}
+}
+
+// run polls until there are no subscribers anymore.
+func (p *livePoller) run() {
+	for {
+		time.Sleep(p.interval())
+
+		livePollersLock.Lock()
+		p.lock.Lock()
+		done := len(p.subscribers) == 0
+		if done {
+			delete(livePollers, p.job.ID)
+		}
+		p.lock.Unlock()
+		livePollersLock.Unlock()
+		if done {
+			return
+		}
+
+		p.poll(time.Now())
+	}
+}
+
+// interval returns the smallest timestep of the metrics subscribed to.
+func (p *livePoller) interval() time.Duration {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	interval := time.Minute
+	for _, m := range p.metrics {
+		if m.timestep > 0 && m.timestep < interval {
+			interval = m.timestep
+		}
+	}
+	return interval
+}
+
+// poll loads the data points of all metrics that have new data points since the last poll
+// and sends them to the subscribers.
+func (p *livePoller) poll(now time.Time) {
+	p.lock.Lock()
+	subscribed := make(map[string]bool)
+	scopesSet := make(map[schema.MetricScope]bool)
+	for sub := range p.subscribers {
+		for metric := range sub.metrics {
+			subscribed[metric] = true
+		}
+		for scope := range sub.scopes {
+			scopesSet[scope] = true
+		}
+	}
+
+	// Metrics with the same time of the next data point are loaded together.
+	due := make(map[time.Time][]string)
+	for metric, m := range p.metrics {
+		if !subscribed[metric] {
+			delete(p.metrics, metric)
+			continue
+		}
+
+		if now.Sub(m.next) >= m.timestep {
+			due[m.next] = append(due[m.next], metric)
+		}
+	}
+	p.lock.Unlock()
+
+	scopes := make([]schema.MetricScope, 0, len(scopesSet))
+	for scope := range scopesSet {
+		scopes = append(scopes, scope)
+	}
+
+	updates := make([]*LiveUpdate, 0)
+	for from, metrics := range due {
+		job := *p.job
+		job.StartTime = from
+		job.StartTimeUnix = from.Unix()
+		job.Duration = int32(now.Sub(from).Seconds())
+
+		ctx, cancel := context.WithTimeout(context.Background(), livePollTimeout)
+		jobData, err := loadFromRepository(&job, metrics, scopes, ctx)
+		cancel()
+		if err != nil {
+			log.Warnf("live update of job %d failed: %s", p.job.ID, err.Error())
+			continue
+		}
+
+		p.lock.Lock()
+		for metric, data := range jobData {
+			m, ok := p.metrics[metric]
+			if !ok {
+				continue
+			}
+
+			metricUpdates, points := liveUpdates(metric, data)
+			if points == 0 {
+				continue
+			}
+
+			offset := int(from.Sub(p.job.StartTime) / m.timestep)
+			for _, u := range metricUpdates {
+				u.Offset = offset
+			}
+			updates = append(updates, metricUpdates...)
+			m.next = from.Add(time.Duration(points) * m.timestep)
+		}
+		p.lock.Unlock()
+	}
+
+	if len(updates) != 0 {
+		p.publish(updates)
+	}
+}
+
+// liveUpdates turns the data of one metric into updates. Data points at the end that are NaN
+// in all series are not available yet and are dropped, so that they are loaded again with the
+// next poll. The second return value is the number of data points per series.
+func liveUpdates(metric string, data map[schema.MetricScope]*schema.JobMetric) ([]*LiveUpdate, int) {
+	points := -1
+	for _, jm := range data {
+		n := 0
+		for _, series := range jm.Series {
+			for i := len(series.Data); i > n; i-- {
+				if !series.Data[i-1].IsNaN() {
+					n = i
+					break
+				}
+			}
+		}
+		if points == -1 || n < points {
+			points = n
+		}
+	}
+	if points <= 0 {
+		return nil, 0
+	}
+
+	updates := make([]*LiveUpdate, 0, len(data))
+	for scope, jm := range data {
+		u := &LiveUpdate{
+			Metric:   metric,
+			Unit:     jm.Unit,
+			Scope:    scope,
+			Timestep: jm.Timestep,
+			Series:   make([]schema.Series, 0, len(jm.Series)),
+		}
+		for _, series := range jm.Series {
+			if len(series.Data) > points {
+				series.Data = series.Data[:points]
+			}
+			series.Statistics = nil // The statistics of the new data points only would be misleading.
+			u.Series = append(u.Series, series)
+		}
+		updates = append(updates, u)
+	}
+	return updates, points
+}
+
+// publish sends every subscriber the updates it subscribed to. Subscribers that are
+// too far behind are dropped.
+func (p *livePoller) publish(updates []*LiveUpdate) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for sub := range p.subscribers {
+		filtered := make([]*LiveUpdate, 0, len(updates))
+		for _, u := range updates {
+			if sub.metrics[u.Metric] && sub.scopes[u.Scope] {
+				filtered = append(filtered, u)
+			}
+		}
+		if len(filtered) == 0 {
+			continue
+		}
+
+		select {
+		case sub.updates <- filtered:
+		default:
+			log.Warnf("live updates of job %d: dropping a subscriber that does not keep up", p.job.ID)
+			delete(p.subscribers, sub)
+			close(sub.updates)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+func TestLiveUpdates(t *testing.T) {
+	if err := archive.Init(json.RawMessage(`{"kind": "file","path": "../../test/archive"}`), false); err != nil {
+		t.Fatal(err)
+	}
+
+	metricDataRepos["emmy"] = &TestMetricDataRepository{}
+	defer delete(metricDataRepos, "emmy")
+
+	// Returns one value per minute of the requested time range, the last one is not available yet.
+	var requested []*schema.Job
+	TestLoadDataCallback = func(job *schema.Job, metrics []string, scopes []schema.MetricScope, ctx context.Context) (schema.JobData, error) {
+		requested = append(requested, job)
+		data := make([]schema.Float, 0)
+		for i := 0; i < int(job.Duration)/60; i++ {
+			data = append(data, schema.Float(i))
+		}
+		data = append(data, schema.NaN)
+
+		jd := schema.JobData{}
+		for _, metric := range metrics {
+			jd[metric] = map[schema.MetricScope]*schema.JobMetric{
+				schema.MetricScopeNode: {Scope: schema.MetricScopeNode, Timestep: 60, Series: []schema.Series{{Hostname: "e0101", Data: data}}},
+			}
+		}
+		return jd, nil
+	}
+
+	start := time.Now().Add(-time.Hour).Truncate(time.Minute)
+	job := &schema.Job{ID: 42, BaseJob: schema.BaseJob{Cluster: "emmy", State: schema.JobStateRunning}, StartTime: start}
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	updates1, err := SubscribeJob(ctx1, job, []string{"mem_used"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+	updates2, err := SubscribeJob(ctx2, job, []string{"mem_used", "flops_any"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	livePollersLock.Lock()
+	p := livePollers[job.ID]
+	livePollersLock.Unlock()
+	if p == nil || len(p.subscribers) != 2 {
+		t.Fatal("expected one poller with two subscribers")
+	}
+
+	p.poll(start.Add(63 * time.Minute))
+	if len(requested) != 1 || requested[0].StartTime != start.Add(60*time.Minute) || requested[0].Duration != 180 {
+		t.Fatalf("unexpected requests: %#v", requested)
+	}
+
+	u := <-updates1
+	if len(u) != 1 || u[0].Metric != "mem_used" || u[0].Offset != 60 || len(u[0].Series[0].Data) != 3 {
+		t.Errorf("unexpected update: %#v", u[0])
+	}
+	if u := <-updates2; len(u) != 2 {
+		t.Errorf("expected updates for two metrics, got %d", len(u))
+	}
+
+	// The data point that was not available is requested again.
+	p.poll(start.Add(65 * time.Minute))
+	if len(requested) != 2 || requested[1].StartTime != start.Add(63*time.Minute) {
+		t.Errorf("unexpected request: %#v", requested[1])
+	}
+	if u := <-updates1; u[0].Offset != 63 {
+		t.Errorf("unexpected offset: %d", u[0].Offset)
+	}
+
+	cancel1()
+	if _, ok := <-updates1; ok {
+		t.Errorf("expected the channel to be closed")
+	}
+
+	if _, err := SubscribeJob(context.Background(), &schema.Job{BaseJob: schema.BaseJob{Cluster: "emmy", State: schema.JobStateCompleted}}, nil, nil); err == nil {
+		t.Errorf("expected an error for a job that is not running")
+	}
+
+	// Metrics without a timestep are polled every minute:
+	mc := archive.GetMetricConfig("emmy", "cpu_load")
+	timestep := mc.Timestep
+	mc.Timestep = 0
+	defer func() { mc.Timestep = timestep }()
+
+	job = &schema.Job{ID: 43, BaseJob: schema.BaseJob{Cluster: "emmy", State: schema.JobStateRunning}, StartTime: start}
+	ctx3, cancel3 := context.WithCancel(context.Background())
+	defer cancel3()
+	updates3, err := SubscribeJob(ctx3, job, []string{"cpu_load"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	livePollersLock.Lock()
+	p = livePollers[job.ID]
+	livePollersLock.Unlock()
+	p.poll(start.Add(62 * time.Minute))
+	if u := <-updates3; len(u) != 1 || u[0].Metric != "cpu_load" || u[0].Offset != 60 {
+		t.Errorf("unexpected update: %#v", u)
+	}
+}
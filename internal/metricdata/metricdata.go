@@ -5,11 +5,16 @@
 package metricdata
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"sort"
+	"strings"
 	"time"
+	"unsafe"
 
 	"github.com/ClusterCockpit/cc-backend/internal/config"
 	"github.com/ClusterCockpit/cc-backend/pkg/archive"
@@ -42,42 +47,74 @@ func Init(disableArchive bool) error {
 	useArchive = !disableArchive
 	for _, cluster := range config.Keys.Clusters {
 		if cluster.MetricDataRepository != nil {
-			var kind struct {
-				Kind string `json:"kind"`
+			mdr, err := newMetricDataRepository(cluster.MetricDataRepository)
+			if err != nil {
+				return fmt.Errorf("metric data repository for cluster '%s': %w", cluster.Name, err)
 			}
-			if err := json.Unmarshal(cluster.MetricDataRepository, &kind); err != nil {
-				return err
+			if err := validateUnits(cluster.Name, mdr); err != nil {
+				return fmt.Errorf("metric data repository for cluster '%s': %w", cluster.Name, err)
 			}
+			metricDataRepos[cluster.Name] = mdr
+		}
+	}
+	if err := validateMetricUnits(); err != nil {
+		return err
+	}
+	return validateDerivedMetrics()
+}
 
-			var mdr MetricDataRepository
-			switch kind.Kind {
-			case "cc-metric-store":
-				mdr = &CCMetricStore{}
-			case "influxdb":
-				mdr = &InfluxDBv2DataRepository{}
-			case "test":
-				mdr = &TestMetricDataRepository{}
-			default:
-				return fmt.Errorf("unkown metric data repository '%s' for cluster '%s'", kind.Kind, cluster.Name)
-			}
+// newMetricDataRepository creates and initializes a MetricDataRepository. A list
+// of repositories is turned into a FailoverRepository, a repository that declares
+// the units of its metrics is wrapped by a UnitConverter.
+func newMetricDataRepository(rawConfig json.RawMessage) (MetricDataRepository, error) {
+	var mdr MetricDataRepository
+	if trimmed := bytes.TrimSpace(rawConfig); len(trimmed) > 0 && trimmed[0] == '[' {
+		mdr = &FailoverRepository{}
+	} else {
+		var kind struct {
+			Kind string `json:"kind"`
+		}
+		if err := json.Unmarshal(rawConfig, &kind); err != nil {
+			return nil, err
+		}
 
-			if err := mdr.Init(cluster.MetricDataRepository); err != nil {
-				return err
-			}
-			metricDataRepos[cluster.Name] = mdr
+		switch kind.Kind {
+		case "cc-metric-store":
+			mdr = &CCMetricStore{}
+		case "influxdb":
+			mdr = &InfluxDBv2DataRepository{}
+		case "prometheus":
+			mdr = &PrometheusDataRepository{}
+		case "files":
+			mdr = &FilesDataRepository{}
+		case "embedded":
+			mdr = &EmbeddedMetricStore{}
+		case "test":
+			mdr = &TestMetricDataRepository{}
+		default:
+			return nil, fmt.Errorf("unkown metric data repository '%s'", kind.Kind)
 		}
 	}
-	return nil
+
+	if err := mdr.Init(rawConfig); err != nil {
+		return nil, err
+	}
+	if _, ok := mdr.(*FailoverRepository); ok {
+		return mdr, nil
+	}
+	return newUnitConverter(mdr, rawConfig)
 }
 
 var cache *lrucache.Cache = lrucache.New(128 * 1024 * 1024)
 
-// Fetches the metric data for a job.
+// Fetches the metric data for a job. If `resolution` is greater than zero, the series
+// are downsampled to at most that many points.
 func LoadData(job *schema.Job,
 	metrics []string,
 	scopes []schema.MetricScope,
-	ctx context.Context) (schema.JobData, error) {
-	data := cache.Get(cacheKey(job, metrics, scopes), func() (_ interface{}, ttl time.Duration, size int) {
+	ctx context.Context,
+	resolution int) (schema.JobData, error) {
+	data := cacheGet(CacheJobData, cacheKey(job, metrics, scopes, resolution), func() (_ interface{}, ttl time.Duration, size int) {
 		var jd schema.JobData
 		var err error
 
@@ -85,30 +122,13 @@ func LoadData(job *schema.Job,
 			job.MonitoringStatus == schema.MonitoringStatusRunningOrArchiving ||
 			!useArchive {
 
-			repo, ok := metricDataRepos[job.Cluster]
-
-			if !ok {
-				return fmt.Errorf("no metric data repository configured for '%s'", job.Cluster), 0, 0
-			}
-
 			if scopes == nil {
 				scopes = append(scopes, schema.MetricScopeNode)
 			}
 
-			if metrics == nil {
-				cluster := archive.GetCluster(job.Cluster)
-				for _, mc := range cluster.MetricConfig {
-					metrics = append(metrics, mc.Name)
-				}
-			}
-
-			jd, err = repo.LoadData(job, metrics, scopes, ctx)
+			jd, err = loadFromRepository(job, metrics, scopes, ctx)
 			if err != nil {
-				if len(jd) != 0 {
-					log.Errorf("partial error: %s", err.Error())
-				} else {
-					return err, 0, 0
-				}
+				return err, 0, 0
 			}
 			size = jd.Size()
 		} else {
@@ -117,6 +137,17 @@ func LoadData(job *schema.Job,
 				return err, 0, 0
 			}
 
+			// Derived metrics configured after the job was archived are computed from the archived data,
+			// data archived in another unit is converted. The archive has its own cache, so the map is
+			// not modified.
+			archived := jd
+			jd = make(schema.JobData, len(archived))
+			for metric, data := range archived {
+				jd[metric] = data
+			}
+			convertArchivedUnits(job.Cluster, jd)
+			deriveMetrics(job.Cluster, jd, nil)
+
 			// Avoid sending unrequested data to the client:
 			if metrics != nil || scopes != nil {
 				if metrics == nil {
@@ -156,6 +187,10 @@ func LoadData(job *schema.Job,
 		}
 
 		prepareJobData(job, jd, scopes)
+		if resolution > 0 {
+			resampleJobData(jd, resolution)
+			size = jd.Size()
+		}
 		return jd, ttl, size
 	})
 
@@ -166,6 +201,54 @@ func LoadData(job *schema.Job,
 	return data.(schema.JobData), nil
 }
 
+// loadFromRepository loads the metric data of a job from the metric data repository of its
+// cluster (not using the cache). Partial errors are logged.
+func loadFromRepository(
+	job *schema.Job,
+	metrics []string,
+	scopes []schema.MetricScope,
+	ctx context.Context) (schema.JobData, error) {
+
+	jd, err := loadFromRepositoryPartial(job, metrics, scopes, ctx)
+	if err != nil {
+		if len(jd) == 0 {
+			return nil, err
+		}
+		log.Errorf("partial error: %s", err.Error())
+	}
+	return jd, nil
+}
+
+// loadFromRepositoryPartial is like loadFromRepository, but partial errors are returned
+// together with the data that could be loaded.
+func loadFromRepositoryPartial(
+	job *schema.Job,
+	metrics []string,
+	scopes []schema.MetricScope,
+	ctx context.Context) (schema.JobData, error) {
+
+	repo, ok := metricDataRepos[job.Cluster]
+	if !ok {
+		return nil, fmt.Errorf("no metric data repository configured for '%s'", job.Cluster)
+	}
+
+	if metrics == nil {
+		cluster := archive.GetCluster(job.Cluster)
+		for _, mc := range cluster.MetricConfig {
+			metrics = append(metrics, mc.Name)
+		}
+	}
+
+	// Derived metrics are computed from other metrics here, the repositories do not know them.
+	loadMetrics, hasDerived := expandMetrics(job.Cluster, metrics)
+	jd, err := repo.LoadData(job, loadMetrics, scopes, ctx)
+	if hasDerived && len(jd) != 0 {
+		deriveMetrics(job.Cluster, jd, metrics)
+		removeUnrequested(jd, metrics)
+	}
+	return jd, err
+}
+
 // Used for the jobsFootprint GraphQL-Query. TODO: Rename/Generalize.
 func LoadAverages(
 	job *schema.Job,
@@ -173,7 +256,48 @@ func LoadAverages(
 	data [][]schema.Float,
 	ctx context.Context) error {
 
-	if job.State != schema.JobStateRunning && useArchive {
+	archived := job.State != schema.JobStateRunning && useArchive
+	if _, hasDerived := expandMetrics(job.Cluster, metrics); !hasDerived {
+		return loadAverages(job, metrics, data, archived, ctx)
+	}
+
+	// Derived metrics are averaged from their node scope data (the statistics of jobs
+	// archived before the metric was configured do not contain them).
+	plainMetrics := make([]string, 0, len(metrics))
+	plainData := make([][]schema.Float, 0, len(metrics))
+	plainIndices := make([]int, 0, len(metrics))
+	for i, m := range metrics {
+		if expr, _ := getDerivedMetric(job.Cluster, m); expr != nil {
+			avg, err := loadDerivedAverage(job, m, archived, ctx)
+			if err != nil {
+				return err
+			}
+			data[i] = append(data[i], avg)
+			continue
+		}
+
+		plainMetrics = append(plainMetrics, m)
+		plainData = append(plainData, data[i])
+		plainIndices = append(plainIndices, i)
+	}
+
+	if err := loadAverages(job, plainMetrics, plainData, archived, ctx); err != nil {
+		return err
+	}
+	for j, i := range plainIndices {
+		data[i] = plainData[j]
+	}
+	return nil
+}
+
+func loadAverages(
+	job *schema.Job,
+	metrics []string,
+	data [][]schema.Float,
+	archived bool,
+	ctx context.Context) error {
+
+	if archived {
 		return archive.LoadAveragesFromArchive(job, metrics, data)
 	}
 
@@ -182,10 +306,28 @@ func LoadAverages(
 		return fmt.Errorf("no metric data repository configured for '%s'", job.Cluster)
 	}
 
-	stats, err := repo.LoadStats(job, metrics, ctx)
-	if err != nil {
+	// The end of the window of running jobs is rounded to the timestep so that the statistics
+	// are only loaded once per timestep.
+	timestep := windowTimestep(job.Cluster, metrics)
+	end := roundTime(job.StartTime.Add(time.Duration(job.Duration)*time.Second), timestep)
+	if end.Before(job.StartTime) {
+		end = job.StartTime
+	}
+	windowJob := *job
+	windowJob.Duration = int32(end.Sub(job.StartTime).Seconds())
+
+	key := fmt.Sprintf("%d(%s):stats:[%v]:%d-%d", job.ID, job.State, metrics, job.StartTime.Unix(), end.Unix())
+	res := cacheGet(CacheStats, key, func() (interface{}, time.Duration, int) {
+		stats, err := repo.LoadStats(&windowJob, metrics, ctx)
+		if err != nil {
+			return err, 0, 0
+		}
+		return stats, cacheTTL(end, timestep), 128 + len(metrics)*int(job.NumNodes)*64
+	})
+	if err, ok := res.(error); ok {
 		return err
 	}
+	stats := res.(map[string]map[string]schema.MetricStatistics)
 
 	for i, m := range metrics {
 		nodes, ok := stats[m]
@@ -204,13 +346,77 @@ func LoadAverages(
 	return nil
 }
 
-// Used for the node/system view. Returns a map of nodes to a map of metrics.
+// loadDerivedAverage returns the same value as loadAverages for a derived metric.
+func loadDerivedAverage(job *schema.Job, metric string, archived bool, ctx context.Context) (schema.Float, error) {
+	if archived {
+		if stats, err := archive.GetStatistics(job); err == nil {
+			if s, ok := stats[metric]; ok {
+				return schema.Float(s.Avg), nil
+			}
+		}
+	}
+
+	jobData, err := LoadData(job, []string{metric}, []schema.MetricScope{schema.MetricScopeNode}, ctx, 0)
+	if err != nil {
+		return schema.NaN, err
+	}
+
+	jm, ok := jobData[metric][schema.MetricScopeNode]
+	if !ok {
+		return schema.NaN, nil
+	}
+
+	sum := 0.0
+	for _, series := range jm.Series {
+		if series.Statistics != nil {
+			sum += series.Statistics.Avg
+		}
+	}
+	if archived {
+		// The statistics in the archive are averaged over all nodes.
+		sum /= float64(job.NumNodes)
+	}
+	return schema.Float(sum), nil
+}
+
+// LoadStatistics returns the statistics of `metric` like they are archived (nil if there is no data).
+// Archived jobs use the statistics in the archive, the statistics of running jobs (and of derived
+// metrics configured after a job was archived) are computed from the node scope data.
+func LoadStatistics(job *schema.Job, metric string, ctx context.Context) (*schema.JobStatistics, error) {
+	if job.State != schema.JobStateRunning && useArchive {
+		if stats, err := archive.GetStatistics(job); err == nil {
+			if s, ok := stats[metric]; ok {
+				return &s, nil
+			}
+		}
+	}
+
+	mc := archive.GetMetricConfig(job.Cluster, metric)
+	if mc == nil {
+		return nil, nil
+	}
+
+	jobData, err := LoadData(job, []string{metric}, []schema.MetricScope{schema.MetricScopeNode}, ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, ok := jobStatistics(job, mc, jobData[metric])
+	if !ok {
+		return nil, nil
+	}
+	return &stats, nil
+}
+
+// Used for the node/system view. Returns a map of nodes to a map of metrics. If `resolution`
+// is greater than zero, the series are downsampled to at most that many points.
 func LoadNodeData(
 	cluster string,
 	metrics, nodes []string,
 	scopes []schema.MetricScope,
 	from, to time.Time,
-	ctx context.Context) (map[string]map[string][]*schema.JobMetric, error) {
+	ctx context.Context,
+	resolution int) (map[string]map[string][]*schema.JobMetric, error) {
 
 	repo, ok := metricDataRepos[cluster]
 	if !ok {
@@ -223,7 +429,38 @@ func LoadNodeData(
 		}
 	}
 
-	data, err := repo.LoadNodeData(cluster, metrics, nodes, scopes, from, to, ctx)
+	// The window is rounded to the timestep so that requests within the same
+	// timestep (e.g. by several users of the system view) share the result.
+	timestep := windowTimestep(cluster, metrics)
+	from, to = roundTime(from, timestep), roundTime(to, timestep)
+	key := fmt.Sprintf("nodeData:%s:[%v]:[%v]:[%v]:%d-%d:%d",
+		cluster, metrics, nodes, scopes, from.Unix(), to.Unix(), resolution)
+	res := cacheGet(CacheNodeData, key, func() (interface{}, time.Duration, int) {
+		data, err := loadNodeData(repo, cluster, metrics, nodes, scopes, from, to, ctx, resolution)
+		if err != nil {
+			return err, 0, 0
+		}
+		return data, cacheTTL(to, timestep), nodeDataSize(data)
+	})
+
+	if err, ok := res.(error); ok {
+		return nil, err
+	}
+	return res.(map[string]map[string][]*schema.JobMetric), nil
+}
+
+// loadNodeData implements LoadNodeData (not using the cache).
+func loadNodeData(
+	repo MetricDataRepository,
+	cluster string,
+	metrics, nodes []string,
+	scopes []schema.MetricScope,
+	from, to time.Time,
+	ctx context.Context,
+	resolution int) (map[string]map[string][]*schema.JobMetric, error) {
+
+	loadMetrics, hasDerived := expandMetrics(cluster, metrics)
+	data, err := repo.LoadNodeData(cluster, loadMetrics, nodes, scopes, from, to, ctx)
 	if err != nil {
 		if len(data) != 0 {
 			log.Errorf("partial error: %s", err.Error())
@@ -232,22 +469,85 @@ func LoadNodeData(
 		}
 	}
 
+	if hasDerived {
+		for host, hostdata := range data {
+			jd := make(schema.JobData, len(hostdata))
+			for metric, jms := range hostdata {
+				jd[metric] = make(map[schema.MetricScope]*schema.JobMetric, len(jms))
+				for _, jm := range jms {
+					jd[metric][jm.Scope] = jm
+				}
+			}
+
+			deriveMetrics(cluster, jd, metrics)
+			removeUnrequested(jd, metrics)
+
+			hostdata = make(map[string][]*schema.JobMetric, len(jd))
+			for metric, scopes := range jd {
+				for _, jm := range scopes {
+					hostdata[metric] = append(hostdata[metric], jm)
+				}
+			}
+			data[host] = hostdata
+		}
+	}
+
 	if data == nil {
 		return nil, fmt.Errorf("the metric data repository for '%s' does not support this query", cluster)
 	}
 
+	if resolution > 0 {
+		for _, hostdata := range data {
+			for _, jms := range hostdata {
+				for i, jm := range jms {
+					jms[i] = resampleJobMetric(jm, resolution)
+				}
+			}
+		}
+	}
+
 	return data, nil
 }
 
+// nodeDataSize estimates the memory used by the result of LoadNodeData.
+func nodeDataSize(data map[string]map[string][]*schema.JobMetric) int {
+	n := 128
+	for _, hostdata := range data {
+		for _, jms := range hostdata {
+			for _, jm := range jms {
+				for _, series := range jm.Series {
+					n += len(series.Data)
+				}
+			}
+		}
+	}
+	return n * int(unsafe.Sizeof(schema.Float(0)))
+}
+
+// removeUnrequested removes metrics that were only loaded to compute derived metrics.
+func removeUnrequested(jobData schema.JobData, metrics []string) {
+	requested := make(map[string]bool, len(metrics))
+	for _, metric := range metrics {
+		requested[metric] = true
+	}
+
+	for metric := range jobData {
+		if !requested[metric] {
+			delete(jobData, metric)
+		}
+	}
+}
+
 func cacheKey(
 	job *schema.Job,
 	metrics []string,
-	scopes []schema.MetricScope) string {
+	scopes []schema.MetricScope,
+	resolution int) string {
 
 	// Duration and StartTime do not need to be in the cache key as StartTime is less unique than
 	// job.ID and the TTL of the cache entry makes sure it does not stay there forever.
-	return fmt.Sprintf("%d(%s):[%v],[%v]",
-		job.ID, job.State, metrics, scopes)
+	return fmt.Sprintf("%d(%s):[%v],[%v],%d",
+		job.ID, job.State, metrics, scopes, resolution)
 }
 
 // For /monitoring/job/<job> and some other places, flops_any and mem_bw need
@@ -283,60 +583,330 @@ func prepareJobData(
 	}
 }
 
-// Writes a running job to the job-archive
+// Writes a running job to the job-archive. Which scopes are archived at which resolution
+// is controlled by the archive policy of the cluster, it is recorded in `JobMeta.Archived`.
 func ArchiveJob(job *schema.Job, ctx context.Context) (*schema.JobMeta, error) {
+	jobMeta, jobData, err := collectArchiveData(job, ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return jobMeta, writeArchive(job, jobMeta, jobData)
+}
+
+var (
+	// ErrNoMetricData is returned by RearchiveJob if the metric data repository has no data for the job (anymore).
+	ErrNoMetricData = errors.New("no metric data available in the metric data repository")
+
+	// ErrIncompleteMetricData is returned by RearchiveJob if some of the data of the job could not
+	// be loaded or metrics or hosts that were archived before are missing.
+	ErrIncompleteMetricData = errors.New("incomplete metric data in the metric data repository")
+)
+
+// RearchiveJob loads the data of an already archived job from the metric data repository again
+// and recomputes its statistics. Unless `dryRun` is true, the job-archive is overwritten. Jobs whose
+// data is not (completely) available in the metric data repository anymore are left untouched.
+func RearchiveJob(job *schema.Job, ctx context.Context, dryRun bool) (*schema.JobMeta, error) {
+	jobMeta, jobData, err := collectArchiveData(job, ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(jobMeta.Statistics) == 0 {
+		return nil, ErrNoMetricData
+	}
+
+	// Data that is complete according to the repository can still lack metrics
+	// or hosts that are in the archive, e.g. if the retention of a metric is shorter.
+	if useArchive {
+		if archived, err := archive.GetHandle().LoadJobMeta(job); err != nil {
+			log.Warnf("re-archive: loading the archived metadata of job (dbid: %d) failed: %s", job.ID, err.Error())
+		} else if err := checkComplete(job, archived, jobData); err != nil {
+			return nil, err
+		}
+	}
 
-	allMetrics := make([]string, 0)
+	if dryRun {
+		return jobMeta, nil
+	}
+	return jobMeta, writeArchive(job, jobMeta, jobData)
+}
+
+// checkComplete returns ErrIncompleteMetricData if a metric of the `archived` job is missing in
+// `jobData` or has no data for one of the hosts of the job (hosts without accelerators do not
+// need data for metrics measured per accelerator).
+func checkComplete(job *schema.Job, archived *schema.JobMeta, jobData schema.JobData) error {
+	for metric := range archived.Statistics {
+		data, ok := jobData[metric]
+		if !ok {
+			return fmt.Errorf("%w: metric '%s' is missing", ErrIncompleteMetricData, metric)
+		}
+
+		hosts := make(map[string]bool)
+		for _, jm := range data {
+			for _, series := range jm.Series {
+				hosts[series.Hostname] = true
+			}
+		}
+
+		mc := archive.GetMetricConfig(job.Cluster, metric)
+		for _, r := range archived.Resources {
+			if mc != nil && mc.Scope == schema.MetricScopeAccelerator && len(r.Accelerators) == 0 {
+				continue
+			}
+			if !hosts[r.Hostname] {
+				return fmt.Errorf("%w: metric '%s' has no data for host '%s'", ErrIncompleteMetricData, metric, r.Hostname)
+			}
+		}
+	}
+	return nil
+}
+
+// collectArchiveData loads the data of a job from the metric data repository (not the job-archive
+// or the cache) and computes the statistics. If `complete` is true, partial errors are returned as
+// ErrIncompleteMetricData and ErrNoMetricData is returned if there is no data at all, otherwise
+// partial errors are logged.
+func collectArchiveData(job *schema.Job, ctx context.Context, complete bool) (*schema.JobMeta, schema.JobData, error) {
+
+	clusterPolicy := getArchivePolicy(job.Cluster)
+	policies := make(map[string]metricArchivePolicy)
+	metricsByScopes := make(map[string][]string)
 	metricConfigs := archive.GetCluster(job.Cluster).MetricConfig
 	for _, mc := range metricConfigs {
-		allMetrics = append(allMetrics, mc.Name)
+		policy := resolveArchivePolicy(job, clusterPolicy, mc)
+		policies[mc.Name] = policy
+		metricsByScopes[policy.key()] = append(metricsByScopes[policy.key()], mc.Name)
 	}
 
-	// TODO: Talk about this! What resolutions to store data at...
-	scopes := []schema.MetricScope{schema.MetricScopeNode}
-	if job.NumNodes <= 8 {
-		scopes = append(scopes, schema.MetricScopeCore)
+	jobData := make(schema.JobData)
+	var errs []string
+	for _, metrics := range metricsByScopes {
+		scopes := policies[metrics[0]].scopes
+		if !complete {
+			data, err := loadFromRepository(job, metrics, scopes, ctx)
+			if err != nil {
+				return nil, nil, err
+			}
+			prepareJobData(job, data, scopes)
+			for metric, scopes := range data {
+				jobData[metric] = scopes
+			}
+			continue
+		}
+
+		data, err := loadFromRepositoryPartial(job, metrics, scopes, ctx)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, nil, ctxErr
+			}
+			errs = append(errs, err.Error())
+		}
+		prepareJobData(job, data, scopes)
+
+		for metric, scopes := range data {
+			jobData[metric] = scopes
+		}
 	}
 
-	jobData, err := LoadData(job, allMetrics, scopes, ctx)
-	if err != nil {
-		return nil, err
+	if complete && len(jobData) == 0 {
+		if len(errs) != 0 {
+			return nil, nil, fmt.Errorf("%w: %s", ErrNoMetricData, strings.Join(errs, ", "))
+		}
+		return nil, nil, ErrNoMetricData
+	}
+	if len(errs) != 0 {
+		return nil, nil, fmt.Errorf("%w: %s", ErrIncompleteMetricData, strings.Join(errs, ", "))
 	}
 
 	jobMeta := &schema.JobMeta{
 		BaseJob:    job.BaseJob,
 		StartTime:  job.StartTime.Unix(),
 		Statistics: make(map[string]schema.JobStatistics),
+		Archived:   make(map[string]schema.ArchivedMetric),
 	}
 
 	for metric, data := range jobData {
-		avg, min, max := 0.0, math.MaxFloat32, -math.MaxFloat32
-		nodeData, ok := data["node"]
-		if !ok {
-			// TODO/FIXME: Calc average for non-node metrics as well!
+		mc := archive.GetMetricConfig(job.Cluster, metric)
+		if mc == nil {
 			continue
 		}
 
-		for _, series := range nodeData.Series {
-			avg += series.Statistics.Avg
-			min = math.Min(min, series.Statistics.Min)
-			max = math.Max(max, series.Statistics.Max)
+		if stats, ok := jobStatistics(job, mc, data); ok {
+			jobMeta.Statistics[metric] = stats
+		}
+	}
+
+	// The statistics above use the full resolution, the data in the archive is downsampled.
+	for metric, data := range jobData {
+		policy := policies[metric]
+		archived := schema.ArchivedMetric{
+			Scopes:   make([]schema.MetricScope, 0, len(data)),
+			MaxNodes: policy.maxNodes,
 		}
 
-		jobMeta.Statistics[metric] = schema.JobStatistics{
-			Unit: archive.GetMetricConfig(job.Cluster, metric).Unit,
-			Avg:  avg / float64(job.NumNodes),
-			Min:  min,
-			Max:  max,
+		scopes := make([]schema.MetricScope, 0, len(data))
+		for scope := range data {
+			scopes = append(scopes, scope)
+		}
+		sort.Slice(scopes, func(i, j int) bool { return scopes[j].LT(scopes[i]) })
+
+		downsampled := make(map[schema.MetricScope]*schema.JobMetric, len(data))
+		for _, scope := range scopes {
+			jm := downsample(data[scope], policy.timestep)
+			downsampled[scope] = jm
+			archived.Scopes = append(archived.Scopes, scope)
+			archived.Timestep = jm.Timestep
 		}
+
+		jobData[metric] = downsampled
+		jobMeta.Archived[metric] = archived
 	}
 
-	// If the file based archive is disabled,
-	// only return the JobMeta structure as the
-	// statistics in there are needed.
+	return jobMeta, jobData, nil
+}
+
+// writeArchive writes the data of a job to the job-archive. Cached data of the job is dropped.
+func writeArchive(job *schema.Job, jobMeta *schema.JobMeta, jobData schema.JobData) error {
+	// If the file based archive is disabled, only the
+	// statistics in the JobMeta structure are used.
 	if !useArchive {
-		return jobMeta, nil
+		return nil
+	}
+
+	defer forgetJob(job.ID)
+	return archive.GetHandle().ImportJob(jobMeta, &jobData)
+}
+
+// forgetJob removes all cached data of a job.
+func forgetJob(id int64) {
+	prefix := fmt.Sprintf("%d(", id)
+	keys := make([]string, 0)
+	cache.Keys(func(key string, _ interface{}) {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	})
+
+	for _, key := range keys {
+		cache.Del(key)
+	}
+}
+
+// jobStatistics combines the statistics of all nodes like they are archived: The minimum and
+// maximum over all nodes and the average of the node averages. ok is false if there is no data.
+func jobStatistics(job *schema.Job, mc *schema.MetricConfig, data map[schema.MetricScope]*schema.JobMetric) (schema.JobStatistics, bool) {
+	nodeStats := nodeStatistics(mc, data)
+	if len(nodeStats) == 0 {
+		return schema.JobStatistics{}, false
+	}
+
+	avg, min, max := 0.0, math.MaxFloat32, -math.MaxFloat32
+	for _, stats := range nodeStats {
+		avg += stats.Avg
+		min = math.Min(min, stats.Min)
+		max = math.Max(max, stats.Max)
+	}
+
+	return schema.JobStatistics{
+		Unit: mc.Unit,
+		Avg:  avg / float64(job.NumNodes),
+		Min:  min,
+		Max:  max,
+	}, true
+}
+
+// nodeStatistics returns the statistics of a metric for every node of a job. If the metric is
+// not available at the node scope, the coarsest available scope is aggregated up to the node
+// using the aggregation of the metric config ("avg" or "sum", the default).
+func nodeStatistics(mc *schema.MetricConfig, data map[schema.MetricScope]*schema.JobMetric) map[string]schema.MetricStatistics {
+	var jm *schema.JobMetric
+	var coarsest schema.MetricScope
+	for scope, scopeData := range data {
+		if jm == nil || coarsest.LT(scope) {
+			jm, coarsest = scopeData, scope
+		}
+	}
+	if jm == nil {
+		return nil
+	}
+
+	hosts := make(map[string][]*schema.Series)
+	for i := range jm.Series {
+		series := &jm.Series[i]
+		hosts[series.Hostname] = append(hosts[series.Hostname], series)
+	}
+
+	avgAggregation := mc.Aggregation != nil && *mc.Aggregation == "avg"
+	stats := make(map[string]schema.MetricStatistics, len(hosts))
+	for host, series := range hosts {
+		if len(series) == 1 && series[0].Statistics != nil {
+			stats[host] = *series[0].Statistics
+			continue
+		}
+
+		if s, ok := aggregateSeriesStatistics(series, avgAggregation); ok {
+			stats[host] = s
+		}
+	}
+
+	return stats
+}
+
+// aggregateSeriesStatistics combines the series of one node timestep by timestep and
+// calculates the statistics of the result. Without data, the statistics of the series
+// are combined instead, which is exact for the average only.
+func aggregateSeriesStatistics(series []*schema.Series, avgAggregation bool) (schema.MetricStatistics, bool) {
+	length := 0
+	for _, s := range series {
+		if len(s.Data) > length {
+			length = len(s.Data)
+		}
 	}
 
-	return jobMeta, archive.GetHandle().ImportJob(jobMeta, &jobData)
+	if length == 0 {
+		n, stats := 0, schema.MetricStatistics{}
+		for _, s := range series {
+			if s.Statistics == nil {
+				continue
+			}
+			stats.Avg += s.Statistics.Avg
+			stats.Min += s.Statistics.Min
+			stats.Max += s.Statistics.Max
+			n += 1
+		}
+		if n == 0 {
+			return stats, false
+		}
+		if avgAggregation {
+			stats.Avg, stats.Min, stats.Max = stats.Avg/float64(n), stats.Min/float64(n), stats.Max/float64(n)
+		}
+		return stats, true
+	}
+
+	sum, count := 0.0, 0
+	min, max := math.MaxFloat64, -math.MaxFloat64
+	for i := 0; i < length; i++ {
+		x, n := 0.0, 0
+		for _, s := range series {
+			if i < len(s.Data) && !s.Data[i].IsNaN() {
+				x += float64(s.Data[i])
+				n += 1
+			}
+		}
+		if n == 0 {
+			continue
+		}
+		if avgAggregation {
+			x /= float64(n)
+		}
+
+		sum += x
+		count += 1
+		min, max = math.Min(min, x), math.Max(max, x)
+	}
+
+	if count == 0 {
+		return schema.MetricStatistics{}, false
+	}
+	return schema.MetricStatistics{Avg: sum / float64(count), Min: min, Max: max}, true
 }
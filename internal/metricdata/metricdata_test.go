@@ -0,0 +1,141 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"testing"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+func TestNodeStatistics(t *testing.T) {
+	id0, id1 := 0, 1
+	accData := map[schema.MetricScope]*schema.JobMetric{
+		schema.MetricScopeAccelerator: {
+			Scope: schema.MetricScopeAccelerator,
+			Series: []schema.Series{
+				{Hostname: "a", Id: &id0, Data: []schema.Float{10, 20, 30}},
+				{Hostname: "a", Id: &id1, Data: []schema.Float{30, schema.NaN, 50}},
+				{Hostname: "b", Id: &id0, Data: []schema.Float{1, 2, 3}, Statistics: &schema.MetricStatistics{Avg: 2, Min: 1, Max: 3}},
+			},
+		},
+	}
+
+	avg, sum := "avg", "sum"
+	stats := nodeStatistics(&schema.MetricConfig{Aggregation: &avg}, accData)
+	if s := stats["a"]; s.Min != 20 || s.Max != 40 || s.Avg != 80./3. {
+		t.Errorf("unexpected statistics for host a (avg): %#v", s)
+	}
+	if s := stats["b"]; s.Min != 1 || s.Max != 3 || s.Avg != 2 {
+		t.Errorf("unexpected statistics for host b: %#v", s)
+	}
+
+	stats = nodeStatistics(&schema.MetricConfig{Aggregation: &sum}, accData)
+	if s := stats["a"]; s.Min != 20 || s.Max != 80 || s.Avg != 140./3. {
+		t.Errorf("unexpected statistics for host a (sum): %#v", s)
+	}
+
+	// The node scope is used if available.
+	accData[schema.MetricScopeNode] = &schema.JobMetric{
+		Scope: schema.MetricScopeNode,
+		Series: []schema.Series{
+			{Hostname: "a", Statistics: &schema.MetricStatistics{Avg: 5, Min: 4, Max: 6}},
+		},
+	}
+	stats = nodeStatistics(&schema.MetricConfig{Aggregation: &sum}, accData)
+	if len(stats) != 1 || stats["a"].Avg != 5 {
+		t.Errorf("expected the node scope statistics, got: %#v", stats)
+	}
+
+	// Without data, the statistics of the series are combined.
+	coreData := map[schema.MetricScope]*schema.JobMetric{
+		schema.MetricScopeCore: {
+			Scope: schema.MetricScopeCore,
+			Series: []schema.Series{
+				{Hostname: "a", Id: &id0, Statistics: &schema.MetricStatistics{Avg: 2, Min: 1, Max: 3}},
+				{Hostname: "a", Id: &id1, Statistics: &schema.MetricStatistics{Avg: 4, Min: 3, Max: 5}},
+			},
+		},
+	}
+	stats = nodeStatistics(&schema.MetricConfig{}, coreData)
+	if s := stats["a"]; s.Avg != 6 || s.Min != 4 || s.Max != 8 {
+		t.Errorf("unexpected statistics without data: %#v", s)
+	}
+}
+
+func TestResolveArchivePolicy(t *testing.T) {
+	two := 2
+	policy := &schema.ArchivePolicy{
+		Scopes:   []schema.MetricScope{schema.MetricScopeSocket, schema.MetricScopeAccelerator},
+		MaxNodes: &two,
+		Timestep: 120,
+		Metrics: map[string]*schema.ArchivePolicy{
+			"mem_bw": {Scopes: []schema.MetricScope{schema.MetricScopeMemoryDomain}, Timestep: 60},
+		},
+	}
+
+	flopsAny := &schema.MetricConfig{Name: "flops_any", Scope: schema.MetricScopeHWThread}
+	memBw := &schema.MetricConfig{Name: "mem_bw", Scope: schema.MetricScopeSocket}
+	accUtil := &schema.MetricConfig{Name: "acc_utilization", Scope: schema.MetricScopeAccelerator}
+
+	job := &schema.Job{BaseJob: schema.BaseJob{NumNodes: 2}}
+	p := resolveArchivePolicy(job, policy, flopsAny)
+	if p.key() != "node,socket" || p.timestep != 120 || p.maxNodes != 2 {
+		t.Errorf("unexpected policy: %#v", p)
+	}
+
+	p = resolveArchivePolicy(job, policy, memBw)
+	if p.key() != "memoryDomain,node" || p.timestep != 60 {
+		t.Errorf("unexpected policy: %#v", p)
+	}
+
+	// Large jobs only get the node scope, jobs with accelerators the accelerator scope for
+	// metrics measured per accelerator.
+	job.NumNodes, job.NumAcc = 3, 4
+	if p := resolveArchivePolicy(job, policy, accUtil); p.key() != "node" {
+		t.Errorf("unexpected policy: %#v", p)
+	}
+	job.NumNodes = 1
+	if p := resolveArchivePolicy(job, policy, accUtil); p.key() != "accelerator,node,socket" {
+		t.Errorf("unexpected policy: %#v", p)
+	}
+	if p := resolveArchivePolicy(job, policy, flopsAny); p.key() != "node,socket" {
+		t.Errorf("unexpected policy: %#v", p)
+	}
+
+	// The defaults:
+	if p := resolveArchivePolicy(job, nil, accUtil); p.key() != "accelerator,core,node" || p.timestep != 0 || p.maxNodes != 8 {
+		t.Errorf("unexpected policy: %#v", p)
+	}
+	if p := resolveArchivePolicy(job, nil, flopsAny); p.key() != "core,node" {
+		t.Errorf("unexpected policy: %#v", p)
+	}
+}
+
+func TestDownsample(t *testing.T) {
+	jm := &schema.JobMetric{
+		Scope:    schema.MetricScopeNode,
+		Timestep: 60,
+		Series: []schema.Series{
+			{Hostname: "a", Data: []schema.Float{1, 3, schema.NaN, schema.NaN, 5}},
+		},
+	}
+
+	res := downsample(jm, 120)
+	if res.Timestep != 120 || len(res.Series[0].Data) != 3 {
+		t.Fatalf("unexpected result: %#v", res)
+	}
+	if data := res.Series[0].Data; data[0] != 2 || !data[1].IsNaN() || data[2] != 5 {
+		t.Errorf("unexpected data: %#v", data)
+	}
+	if len(jm.Series[0].Data) != 5 {
+		t.Errorf("the original data must not be modified")
+	}
+
+	// Not a multiple of the timestep:
+	if res := downsample(jm, 90); res != jm {
+		t.Errorf("expected the data to be unchanged")
+	}
+}
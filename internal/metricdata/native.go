@@ -0,0 +1,415 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+// Series in the native scope of their metric (the scope from the cluster.json), keyed by metric,
+// hostname and type-id (-1 for node level series). All series of a metric are aligned to the
+// requested time range with a value every timestep of the metric, missing values are NaN.
+type nativeSeries map[string]map[string]map[int][]schema.Float
+
+// A nativeSeriesLoader loads the series of the given metrics from `from` to `to` (Unix timestamps)
+// of the given hosts (of all hosts if nil). Used by metric data repositories that only store
+// the native scope of every metric, see loadNativeJobData and loadNativeNodeData.
+type nativeSeriesLoader func(
+	ctx context.Context,
+	cluster string,
+	metrics map[string]*schema.MetricConfig,
+	hosts []string,
+	from, to int64) (nativeSeries, error)
+
+// nativeTypeId converts a type-id of the native scope to the id of a series. Accelerators can be
+// identified by their id from the topology as well. `topologies` caches the topologies of the nodes.
+func nativeTypeId(
+	cluster, host string,
+	scope schema.MetricScope,
+	typeId string,
+	topologies map[string]*schema.Topology) (int, bool) {
+
+	if id, err := strconv.Atoi(typeId); err == nil {
+		return id, true
+	}
+	if scope != schema.MetricScopeAccelerator {
+		return 0, false
+	}
+
+	topology, ok := topologies[host]
+	if !ok {
+		topology, _ = getTopology(cluster, host)
+		topologies[host] = topology
+	}
+	if topology == nil {
+		return 0, false
+	}
+	return topology.GetAcceleratorIndex(typeId)
+}
+
+// loadNativeJobData implements MetricDataRepository.LoadData using `load`. Every metric is aggregated
+// to the requested scopes (see aggregateSeries), scopes finer than the native one are skipped.
+func loadNativeJobData(
+	name string,
+	load nativeSeriesLoader,
+	job *schema.Job,
+	metrics []string,
+	scopes []schema.MetricScope,
+	ctx context.Context) (schema.JobData, error) {
+
+	configs := make(map[string]*schema.MetricConfig, len(metrics))
+	for _, metric := range metrics {
+		if mc := archive.GetMetricConfig(job.Cluster, metric); mc != nil {
+			configs[metric] = mc
+		}
+	}
+
+	nodes := make([]string, 0, len(job.Resources))
+	for _, r := range job.Resources {
+		nodes = append(nodes, r.Hostname)
+	}
+
+	from := job.StartTime.Unix()
+	native, err := load(ctx, job.Cluster, configs, nodes, from, from+int64(job.Duration))
+	if err != nil {
+		return nil, err
+	}
+
+	var errors []string
+	jobData := make(schema.JobData)
+	for _, metric := range metrics {
+		mc, ok := configs[metric]
+		if !ok {
+			continue
+		}
+
+		for _, scope := range scopes {
+			if scope == schema.MetricScopeAccelerator && job.NumAcc == 0 {
+				continue
+			}
+			if _, ok := jobData[metric][scope]; ok || !canAggregate(mc.Scope, scope) {
+				continue
+			}
+
+			jobMetric := &schema.JobMetric{
+				Unit:     mc.Unit,
+				Scope:    scope,
+				Timestep: mc.Timestep,
+				Series:   make([]schema.Series, 0, len(job.Resources)),
+			}
+
+			for _, r := range job.Resources {
+				hostSeries, ok := native[metric][r.Hostname]
+				if !ok {
+					continue
+				}
+
+				series, err := aggregateSeries(job.Cluster, r.Hostname, mc, jobHardware(job.Cluster, r, mc.Scope, hostSeries), scope)
+				if err != nil {
+					errors = append(errors, fmt.Sprintf("fetching %s (scope: %s) for node %s failed: %s", metric, scope, r.Hostname, err.Error()))
+					continue
+				}
+				jobMetric.Series = append(jobMetric.Series, series...)
+			}
+
+			// So that one can later check len(jobData):
+			if len(jobMetric.Series) == 0 {
+				continue
+			}
+
+			if _, ok := jobData[metric]; !ok {
+				jobData[metric] = make(map[schema.MetricScope]*schema.JobMetric)
+			}
+			jobData[metric][scope] = jobMetric
+		}
+	}
+
+	if len(errors) != 0 {
+		return jobData, fmt.Errorf("%s: %s", name, strings.Join(errors, ", "))
+	}
+
+	return jobData, nil
+}
+
+// jobHardware removes the series of sockets/cores/hwthreads/accelerators that are not used by the job.
+func jobHardware(
+	cluster string,
+	r *schema.Resource,
+	scope schema.MetricScope,
+	series map[int][]schema.Float) map[int][]schema.Float {
+
+	if scope == schema.MetricScopeNode ||
+		(scope == schema.MetricScopeAccelerator && r.Accelerators == nil) ||
+		(scope != schema.MetricScopeAccelerator && r.HWThreads == nil) {
+		return series
+	}
+
+	topology, err := getTopology(cluster, r.Hostname)
+	if err != nil {
+		return series
+	}
+
+	var ids []int
+	switch scope {
+	case schema.MetricScopeAccelerator:
+		for _, acc := range r.Accelerators {
+			if idx, ok := topology.GetAcceleratorIndex(acc); ok {
+				ids = append(ids, idx)
+			}
+		}
+	case schema.MetricScopeHWThread:
+		ids = r.HWThreads
+	case schema.MetricScopeCore:
+		ids, _ = topology.GetCoresFromHWThreads(r.HWThreads)
+	case schema.MetricScopeMemoryDomain:
+		ids, _ = topology.GetMemoryDomainsFromHWThreads(r.HWThreads)
+	case schema.MetricScopeSocket:
+		ids, _ = topology.GetSocketsFromHWThreads(r.HWThreads)
+	}
+
+	used := make(map[int][]schema.Float, len(ids))
+	for _, id := range ids {
+		if data, ok := series[id]; ok {
+			used[id] = data
+		}
+	}
+	return used
+}
+
+// loadNativeStats implements MetricDataRepository.LoadStats using `load`.
+func loadNativeStats(
+	name string,
+	load nativeSeriesLoader,
+	job *schema.Job,
+	metrics []string,
+	ctx context.Context) (map[string]map[string]schema.MetricStatistics, error) {
+
+	jobData, err := loadNativeJobData(name, load, job, metrics, []schema.MetricScope{schema.MetricScopeNode}, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make(map[string]map[string]schema.MetricStatistics, len(metrics))
+	for metric, scopes := range jobData {
+		jobMetric, ok := scopes[schema.MetricScopeNode]
+		if !ok {
+			continue
+		}
+
+		metricdata := make(map[string]schema.MetricStatistics, job.NumNodes)
+		for _, series := range jobMetric.Series {
+			metricdata[series.Hostname] = *series.Statistics
+		}
+		stats[metric] = metricdata
+	}
+
+	return stats, nil
+}
+
+// loadNativeNodeData implements MetricDataRepository.LoadNodeData using `load`.
+func loadNativeNodeData(
+	name string,
+	load nativeSeriesLoader,
+	cluster string,
+	metrics, nodes []string,
+	scopes []schema.MetricScope,
+	from, to time.Time,
+	ctx context.Context) (map[string]map[string][]*schema.JobMetric, error) {
+
+	var errors []string
+	configs := make(map[string]*schema.MetricConfig, len(metrics))
+	for _, metric := range metrics {
+		mc := archive.GetMetricConfig(cluster, metric)
+		if mc == nil {
+			errors = append(errors, fmt.Sprintf("metric %s is not configured for cluster %s", metric, cluster))
+			continue
+		}
+		configs[metric] = mc
+	}
+
+	native, err := load(ctx, cluster, configs, nodes, from.Unix(), to.Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]map[string][]*schema.JobMetric)
+	for _, metric := range metrics {
+		mc, ok := configs[metric]
+		if !ok {
+			continue
+		}
+
+		for host, hostSeries := range native[metric] {
+			for _, scope := range scopes {
+				if !canAggregate(mc.Scope, scope) {
+					continue
+				}
+
+				series, err := aggregateSeries(cluster, host, mc, hostSeries, scope)
+				if err != nil {
+					errors = append(errors, fmt.Sprintf("fetching %s for node %s failed: %s", metric, host, err.Error()))
+					continue
+				}
+
+				hostdata, ok := data[host]
+				if !ok {
+					hostdata = make(map[string][]*schema.JobMetric)
+					data[host] = hostdata
+				}
+
+				hostdata[metric] = append(hostdata[metric], &schema.JobMetric{
+					Unit:     mc.Unit,
+					Scope:    scope,
+					Timestep: mc.Timestep,
+					Series:   series,
+				})
+			}
+		}
+	}
+
+	if len(errors) != 0 {
+		return data, fmt.Errorf("%s: %s", name, strings.Join(errors, ", "))
+	}
+
+	return data, nil
+}
+
+// aggregateSeries converts the native series of one node, keyed by type-id (-1 for node level
+// series), to the requested scope. Used by metric data repositories that only store the native scope.
+func aggregateSeries(
+	cluster, host string,
+	mc *schema.MetricConfig,
+	native map[int][]schema.Float,
+	scope schema.MetricScope) ([]schema.Series, error) {
+
+	// Target id of every native type-id, -1 for node scope.
+	targets := make(map[int]int, len(native))
+	switch {
+	case !canAggregate(mc.Scope, scope):
+		return nil, fmt.Errorf("scope %s is not supported for metrics with the native scope %s", scope, mc.Scope)
+	case scope == mc.Scope || scope == schema.MetricScopeNode:
+		for typeId := range native {
+			if scope == schema.MetricScopeNode {
+				targets[typeId] = -1
+			} else {
+				targets[typeId] = typeId
+			}
+		}
+	default:
+		topology, err := getTopology(cluster, host)
+		if err != nil {
+			return nil, err
+		}
+
+		for typeId := range native {
+			hwthreads := []int{typeId}
+			if mc.Scope == schema.MetricScopeCore {
+				if typeId < 0 || typeId >= len(topology.Core) {
+					return nil, fmt.Errorf("core %d does not exist", typeId)
+				}
+				hwthreads = topology.Core[typeId]
+			}
+
+			var ids []int
+			if scope == schema.MetricScopeSocket {
+				ids, _ = topology.GetSocketsFromHWThreads(hwthreads)
+			} else {
+				ids, _ = topology.GetCoresFromHWThreads(hwthreads)
+			}
+			if len(ids) != 1 {
+				return nil, fmt.Errorf("%s %d can not be mapped to a %s", mc.Scope, typeId, scope)
+			}
+			targets[typeId] = ids[0]
+		}
+	}
+
+	avg := mc.Aggregation != nil && *mc.Aggregation == "avg"
+	sums := make(map[int][]schema.Float)
+	counts := make(map[int][]int)
+	for typeId, data := range native {
+		target := targets[typeId]
+		sum, ok := sums[target]
+		if !ok {
+			sum = make([]schema.Float, 0, len(data))
+		}
+		count := counts[target]
+		for i, x := range data {
+			if i >= len(sum) {
+				sum = append(sum, schema.NaN)
+				count = append(count, 0)
+			}
+			if x.IsNaN() {
+				continue
+			}
+			if sum[i].IsNaN() {
+				sum[i] = 0
+			}
+			sum[i] += x
+			count[i] += 1
+		}
+		sums[target], counts[target] = sum, count
+	}
+
+	ids := make([]int, 0, len(sums))
+	for id := range sums {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	series := make([]schema.Series, 0, len(ids))
+	for _, id := range ids {
+		data := sums[id]
+		if avg {
+			for i := range data {
+				if counts[id][i] > 0 {
+					data[i] /= schema.Float(counts[id][i])
+				}
+			}
+		}
+
+		s := schema.Series{
+			Hostname:   host,
+			Data:       data,
+			Statistics: seriesStatistics(data),
+		}
+		if id >= 0 {
+			id := id
+			s.Id = &id
+		}
+		series = append(series, s)
+	}
+
+	return series, nil
+}
+
+// canAggregate returns true if aggregateSeries can convert series of the native scope to `scope`.
+func canAggregate(native, scope schema.MetricScope) bool {
+	if scope == native || scope == schema.MetricScopeNode {
+		return true
+	}
+	return (scope == schema.MetricScopeSocket || scope == schema.MetricScopeCore) &&
+		(native == schema.MetricScopeCore || native == schema.MetricScopeHWThread)
+}
+
+func getTopology(cluster, host string) (*schema.Topology, error) {
+	subcluster, err := archive.GetSubClusterByNode(cluster, host)
+	if err != nil {
+		return nil, err
+	}
+
+	sc := archive.GetSubCluster(cluster, subcluster)
+	if sc == nil || sc.Topology == nil {
+		return nil, fmt.Errorf("no topology for subcluster %s", subcluster)
+	}
+	return sc.Topology, nil
+}
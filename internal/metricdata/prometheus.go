@@ -0,0 +1,555 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+type PrometheusDataRepositoryConfig struct {
+	Kind     string `json:"kind"`
+	Url      string `json:"url"`
+	Token    string `json:"token"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+
+	// Name of the label that contains the hostname (default: "hostname")
+	// and of the label that contains the socket/core/hwthread/accelerator id (default: "id").
+	// Use `label_replace` in the templates if the exporters use other labels.
+	HostLabel string `json:"hostLabel"`
+	IdLabel   string `json:"idLabel"`
+
+	// PromQL templates for every metric from the `metricConfig` section of the 'cluster.json'
+	// and every scope it should be available in. The templates are executed with
+	// `PrometheusQueryArgs` using Go's text/template package, e.g.:
+	//   "mem_used": {"node": "node_memory_Active_bytes{hostname=~\"{{.Nodes}}\"} / 1e9"}
+	Templates map[string]map[schema.MetricScope]string `json:"templates"`
+}
+
+// The arguments available in the PromQL templates.
+type PrometheusQueryArgs struct {
+	Cluster  string
+	Nodes    string // Regular expression matching all requested hostnames, escaped for a PromQL string in double quotes
+	Step     string // Timestep of the metric as Prometheus duration, e.g. "60s"
+	Timestep int    // Timestep of the metric in seconds
+}
+
+type PrometheusDataRepository struct {
+	url       string
+	token     string
+	username  string
+	password  string
+	hostLabel string
+	idLabel   string
+	client    http.Client
+	templates map[string]map[schema.MetricScope]*template.Template
+}
+
+// The parts of the response of the `/api/v1/query_range` endpoint that are used.
+type promQueryRangeResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Metric map[string]string `json:"metric"`
+			Values [][2]interface{}  `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// A single series of a query result, already aligned to the requested time range.
+type promSeries struct {
+	hostname string
+	id       string
+	data     []schema.Float
+}
+
+func (pdb *PrometheusDataRepository) Init(rawConfig json.RawMessage) error {
+	var config PrometheusDataRepositoryConfig
+	if err := json.Unmarshal(rawConfig, &config); err != nil {
+		return err
+	}
+
+	if config.Url == "" {
+		return errors.New("prometheus: the url is required")
+	}
+
+	pdb.url = strings.TrimSuffix(config.Url, "/")
+	pdb.token = config.Token
+	pdb.username = config.Username
+	pdb.password = config.Password
+	pdb.hostLabel = config.HostLabel
+	if pdb.hostLabel == "" {
+		pdb.hostLabel = "hostname"
+	}
+	pdb.idLabel = config.IdLabel
+	if pdb.idLabel == "" {
+		pdb.idLabel = "id"
+	}
+	pdb.client = http.Client{
+		Timeout: 10 * time.Second,
+	}
+
+	pdb.templates = make(map[string]map[schema.MetricScope]*template.Template, len(config.Templates))
+	for metric, scopes := range config.Templates {
+		pdb.templates[metric] = make(map[schema.MetricScope]*template.Template, len(scopes))
+		for scope, tmpl := range scopes {
+			if !scope.Valid() {
+				return fmt.Errorf("prometheus: invalid scope '%s' for metric '%s'", scope, metric)
+			}
+
+			t, err := template.New(fmt.Sprintf("%s/%s", metric, scope)).Option("missingkey=error").Parse(tmpl)
+			if err != nil {
+				return fmt.Errorf("prometheus: template for metric '%s' and scope '%s': %w", metric, scope, err)
+			}
+			pdb.templates[metric][scope] = t
+		}
+	}
+
+	return nil
+}
+
+// nodesRegex returns a regular expression that matches exactly the given hostnames. It is escaped
+// for a double quoted PromQL string, which does not accept escapes like `\.` from regexp.QuoteMeta.
+func (pdb *PrometheusDataRepository) nodesRegex(nodes []string) string {
+	if nodes == nil {
+		return ".+"
+	}
+
+	quoted := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		quoted = append(quoted, regexp.QuoteMeta(node))
+	}
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(strings.Join(quoted, "|"))
+}
+
+// buildQuery executes the template of the metric for the given scope. The second return value
+// is false if there is no template for this combination.
+func (pdb *PrometheusDataRepository) buildQuery(
+	cluster, metric string,
+	scope schema.MetricScope,
+	nodes []string,
+	timestep int) (string, bool, error) {
+
+	tmpl, ok := pdb.templates[metric][scope]
+	if !ok {
+		return "", false, nil
+	}
+
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, PrometheusQueryArgs{
+		Cluster:  cluster,
+		Nodes:    pdb.nodesRegex(nodes),
+		Step:     fmt.Sprintf("%ds", timestep),
+		Timestep: timestep,
+	}); err != nil {
+		return "", true, err
+	}
+
+	return buf.String(), true, nil
+}
+
+// queryRange executes a PromQL query using the `query_range` API and aligns every returned series
+// to the range from `from` to `to` with a value every `timestep` seconds. Missing values are NaN.
+func (pdb *PrometheusDataRepository) queryRange(
+	ctx context.Context,
+	query string,
+	from, to time.Time,
+	timestep int) ([]promSeries, error) {
+
+	if timestep <= 0 {
+		return nil, fmt.Errorf("invalid timestep: %d", timestep)
+	}
+
+	start, end := from.Unix(), to.Unix()
+	if end < start {
+		end = start
+	}
+
+	form := url.Values{}
+	form.Set("query", query)
+	form.Set("start", strconv.FormatInt(start, 10))
+	form.Set("end", strconv.FormatInt(end, 10))
+	form.Set("step", strconv.Itoa(timestep))
+
+	endpoint := pdb.url + "/api/v1/query_range"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if pdb.token != "" {
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", pdb.token))
+	} else if pdb.username != "" {
+		req.SetBasicAuth(pdb.username, pdb.password)
+	}
+
+	res, err := pdb.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	var resBody promQueryRangeResponse
+	if err := json.NewDecoder(bufio.NewReader(res.Body)).Decode(&resBody); err != nil {
+		if res.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("'%s': HTTP Status: %s", endpoint, res.Status)
+		}
+		return nil, err
+	}
+
+	if resBody.Status != "success" {
+		return nil, fmt.Errorf("'%s': %s: %s", endpoint, resBody.ErrorType, resBody.Error)
+	}
+	if resBody.Data.ResultType != "matrix" {
+		return nil, fmt.Errorf("'%s': unexpected result type '%s'", endpoint, resBody.Data.ResultType)
+	}
+
+	n := int((end-start)/int64(timestep)) + 1
+	series := make([]promSeries, 0, len(resBody.Data.Result))
+	for _, result := range resBody.Data.Result {
+		s := promSeries{
+			hostname: result.Metric[pdb.hostLabel],
+			id:       result.Metric[pdb.idLabel],
+			data:     make([]schema.Float, n),
+		}
+		for i := range s.data {
+			s.data[i] = schema.NaN
+		}
+
+		for _, value := range result.Values {
+			ts, ok := value[0].(float64)
+			str, ok2 := value[1].(string)
+			if !ok || !ok2 {
+				return nil, fmt.Errorf("'%s': invalid sample %v", endpoint, value)
+			}
+
+			i := int(math.Round((ts - float64(start)) / float64(timestep)))
+			if i < 0 || i >= n {
+				continue
+			}
+
+			x, err := strconv.ParseFloat(str, 64)
+			if err != nil || math.IsInf(x, 0) {
+				continue
+			}
+			s.data[i] = schema.Float(x)
+		}
+
+		series = append(series, s)
+	}
+
+	return series, nil
+}
+
+func (pdb *PrometheusDataRepository) LoadData(
+	job *schema.Job,
+	metrics []string,
+	scopes []schema.MetricScope,
+	ctx context.Context) (schema.JobData, error) {
+
+	var topology *schema.Topology
+	if sc := archive.GetSubCluster(job.Cluster, job.SubCluster); sc != nil {
+		topology = sc.Topology
+	}
+
+	hosts := make(map[string]*schema.Resource, len(job.Resources))
+	nodes := make([]string, 0, len(job.Resources))
+	for _, r := range job.Resources {
+		hosts[r.Hostname] = r
+		nodes = append(nodes, r.Hostname)
+	}
+
+	from := job.StartTime
+	to := job.StartTime.Add(time.Duration(job.Duration) * time.Second)
+
+	var errors []string
+	jobData := make(schema.JobData)
+	for _, metric := range metrics {
+		mc := archive.GetMetricConfig(job.Cluster, metric)
+		if mc == nil {
+			continue
+		}
+
+		for _, scope := range scopes {
+			if scope == schema.MetricScopeAccelerator && job.NumAcc == 0 {
+				continue
+			}
+			if _, ok := jobData[metric][scope]; ok {
+				continue
+			}
+
+			query, ok, err := pdb.buildQuery(job.Cluster, metric, scope, nodes, mc.Timestep)
+			if !ok {
+				continue
+			}
+			if err != nil {
+				errors = append(errors, fmt.Sprintf("failed to build query for '%s' (scope: %s): %s", metric, scope, err.Error()))
+				continue
+			}
+
+			res, err := pdb.queryRange(ctx, query, from, to, mc.Timestep)
+			if err != nil {
+				errors = append(errors, fmt.Sprintf("failed to fetch '%s' (scope: %s): %s", metric, scope, err.Error()))
+				continue
+			}
+
+			jobMetric := &schema.JobMetric{
+				Unit:     mc.Unit,
+				Scope:    scope,
+				Timestep: mc.Timestep,
+				Series:   make([]schema.Series, 0, len(res)),
+			}
+
+			for _, s := range res {
+				resource, ok := hosts[s.hostname]
+				if !ok {
+					continue
+				}
+
+				id, ok := pdb.seriesId(s, scope, resource, topology)
+				if !ok {
+					continue
+				}
+
+				jobMetric.Series = append(jobMetric.Series, schema.Series{
+					Hostname:   s.hostname,
+					Id:         id,
+					Statistics: seriesStatistics(s.data),
+					Data:       s.data,
+				})
+			}
+
+			// So that one can later check len(jobData):
+			if len(jobMetric.Series) == 0 {
+				continue
+			}
+
+			sortSeries(jobMetric.Series)
+			if _, ok := jobData[metric]; !ok {
+				jobData[metric] = make(map[schema.MetricScope]*schema.JobMetric)
+			}
+			jobData[metric][scope] = jobMetric
+		}
+	}
+
+	if len(errors) != 0 {
+		return jobData, fmt.Errorf("prometheus: %s", strings.Join(errors, ", "))
+	}
+
+	return jobData, nil
+}
+
+// seriesId returns the id of a series for sub-node scopes. The second return value is false
+// if the socket/core/hwthread/accelerator is not used by the job.
+func (pdb *PrometheusDataRepository) seriesId(
+	s promSeries,
+	scope schema.MetricScope,
+	resource *schema.Resource,
+	topology *schema.Topology) (*int, bool) {
+
+	if scope == schema.MetricScopeNode {
+		return nil, true
+	}
+
+	if scope == schema.MetricScopeAccelerator {
+		if resource.Accelerators != nil && !contains(resource.Accelerators, s.id) {
+			return nil, false
+		}
+		if topology != nil {
+			if idx, ok := topology.GetAcceleratorIndex(s.id); ok {
+				return &idx, true
+			}
+		}
+	}
+
+	id, err := strconv.Atoi(s.id)
+	if err != nil {
+		return nil, false
+	}
+
+	// Jobs that do not use whole nodes only get the series of their own hardware.
+	if resource.HWThreads != nil && topology != nil {
+		var ids []int
+		switch scope {
+		case schema.MetricScopeHWThread:
+			ids = resource.HWThreads
+		case schema.MetricScopeCore:
+			ids, _ = topology.GetCoresFromHWThreads(resource.HWThreads)
+		case schema.MetricScopeMemoryDomain:
+			ids, _ = topology.GetMemoryDomainsFromHWThreads(resource.HWThreads)
+		case schema.MetricScopeSocket:
+			ids, _ = topology.GetSocketsFromHWThreads(resource.HWThreads)
+		}
+		if ids != nil && !containsInt(ids, id) {
+			return nil, false
+		}
+	}
+
+	return &id, true
+}
+
+func (pdb *PrometheusDataRepository) LoadStats(
+	job *schema.Job,
+	metrics []string,
+	ctx context.Context) (map[string]map[string]schema.MetricStatistics, error) {
+
+	jobData, err := pdb.LoadData(job, metrics, []schema.MetricScope{schema.MetricScopeNode}, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make(map[string]map[string]schema.MetricStatistics, len(metrics))
+	for metric, scopes := range jobData {
+		jobMetric, ok := scopes[schema.MetricScopeNode]
+		if !ok {
+			continue
+		}
+
+		metricdata := make(map[string]schema.MetricStatistics, job.NumNodes)
+		for _, series := range jobMetric.Series {
+			metricdata[series.Hostname] = *series.Statistics
+		}
+		stats[metric] = metricdata
+	}
+
+	return stats, nil
+}
+
+func (pdb *PrometheusDataRepository) LoadNodeData(
+	cluster string,
+	metrics, nodes []string,
+	scopes []schema.MetricScope,
+	from, to time.Time,
+	ctx context.Context) (map[string]map[string][]*schema.JobMetric, error) {
+
+	var errors []string
+	data := make(map[string]map[string][]*schema.JobMetric)
+	for _, metric := range metrics {
+		mc := archive.GetMetricConfig(cluster, metric)
+		if mc == nil {
+			errors = append(errors, fmt.Sprintf("metric %s is not configured for cluster %s", metric, cluster))
+			continue
+		}
+
+		for _, scope := range scopes {
+			query, ok, err := pdb.buildQuery(cluster, metric, scope, nodes, mc.Timestep)
+			if !ok {
+				continue
+			}
+			if err != nil {
+				errors = append(errors, fmt.Sprintf("failed to build query for '%s' (scope: %s): %s", metric, scope, err.Error()))
+				continue
+			}
+
+			res, err := pdb.queryRange(ctx, query, from, to, mc.Timestep)
+			if err != nil {
+				errors = append(errors, fmt.Sprintf("fetching %s (scope: %s) failed: %s", metric, scope, err.Error()))
+				continue
+			}
+
+			byHost := make(map[string]*schema.JobMetric)
+			for _, s := range res {
+				if s.hostname == "" {
+					continue
+				}
+
+				var id *int
+				if scope != schema.MetricScopeNode {
+					x, err := strconv.Atoi(s.id)
+					if err != nil {
+						continue
+					}
+					id = &x
+				}
+
+				jobMetric, ok := byHost[s.hostname]
+				if !ok {
+					jobMetric = &schema.JobMetric{
+						Unit:     mc.Unit,
+						Scope:    scope,
+						Timestep: mc.Timestep,
+						Series:   make([]schema.Series, 0, 1),
+					}
+					byHost[s.hostname] = jobMetric
+				}
+
+				jobMetric.Series = append(jobMetric.Series, schema.Series{
+					Hostname:   s.hostname,
+					Id:         id,
+					Statistics: seriesStatistics(s.data),
+					Data:       s.data,
+				})
+			}
+
+			for host, jobMetric := range byHost {
+				hostdata, ok := data[host]
+				if !ok {
+					hostdata = make(map[string][]*schema.JobMetric)
+					data[host] = hostdata
+				}
+
+				sortSeries(jobMetric.Series)
+				hostdata[metric] = append(hostdata[metric], jobMetric)
+			}
+		}
+	}
+
+	if len(errors) != 0 {
+		return data, fmt.Errorf("prometheus: %s", strings.Join(errors, ", "))
+	}
+
+	return data, nil
+}
+
+// sortSeries sorts by hostname first and id second.
+func sortSeries(series []schema.Series) {
+	sort.Slice(series, func(i, j int) bool {
+		if series[i].Hostname != series[j].Hostname {
+			return series[i].Hostname < series[j].Hostname
+		}
+		if series[i].Id == nil || series[j].Id == nil {
+			return series[j].Id != nil
+		}
+		return *series[i].Id < *series[j].Id
+	})
+}
+
+func contains(s []string, x string) bool {
+	for _, y := range s {
+		if x == y {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(s []int, x int) bool {
+	for _, y := range s {
+		if x == y {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,235 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+// A fake Prometheus server: Queries containing "node_load" return one series per requested node,
+// queries containing "node_cpu" return two hwthreads per node, everything else fails. Like Prometheus,
+// it rejects unknown escapes in the string with the hostname regex.
+func fakePrometheus(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/query_range" || r.Header.Get("Authorization") != "Bearer secret" {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		query := r.FormValue("query")
+		start, _ := strconv.ParseInt(r.FormValue("start"), 10, 64)
+		end, _ := strconv.ParseInt(r.FormValue("end"), 10, 64)
+		step, _ := strconv.ParseInt(r.FormValue("step"), 10, 64)
+
+		i, j := strings.Index(query, `=~"`), -1
+		if i != -1 {
+			for j = i + 3; j < len(query) && query[j] != '"'; j++ {
+				if query[j] == '\\' {
+					j++
+				}
+			}
+		}
+		regex, err := "", errors.New("no hostname regex")
+		if i != -1 && j < len(query) {
+			regex, err = strconv.Unquote(query[i+2 : j+1])
+		}
+		if err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(rw, `{"status":"error","errorType":"bad_data","error":"parse error"}`)
+			return
+		}
+		nodes := strings.Split(regex, "|")
+		for i := range nodes {
+			nodes[i] = regexp.MustCompile(`\\(.)`).ReplaceAllString(nodes[i], "$1")
+		}
+
+		type result struct {
+			Metric map[string]string `json:"metric"`
+			Values [][2]interface{}  `json:"values"`
+		}
+		results := []result{}
+		for _, node := range nodes {
+			ids := []string{""}
+			if strings.Contains(query, "node_cpu") {
+				ids = []string{"0", "1"}
+			} else if !strings.Contains(query, "node_load") {
+				rw.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(rw, `{"status":"error","errorType":"bad_data","error":"unknown metric"}`)
+				return
+			}
+
+			for _, id := range ids {
+				res := result{Metric: map[string]string{"hostname": node}}
+				if id != "" {
+					res.Metric["cpu"] = id
+				}
+				// The first sample is missing, the values count up.
+				for ts := start + step; ts <= end; ts += step {
+					res.Values = append(res.Values, [2]interface{}{ts, strconv.FormatInt((ts-start)/step, 10)})
+				}
+				results = append(results, res)
+			}
+		}
+
+		json.NewEncoder(rw).Encode(map[string]interface{}{
+			"status": "success",
+			"data": map[string]interface{}{
+				"resultType": "matrix",
+				"result":     results,
+			},
+		})
+	}))
+}
+
+func setupPrometheus(t *testing.T, url string) *PrometheusDataRepository {
+	if err := archive.Init(json.RawMessage(`{"kind": "file","path": "../../test/archive"}`), false); err != nil {
+		t.Fatal(err)
+	}
+
+	pdb := &PrometheusDataRepository{}
+	if err := pdb.Init(json.RawMessage(`{
+		"kind": "prometheus",
+		"url": "` + url + `",
+		"token": "secret",
+		"idLabel": "cpu",
+		"templates": {
+			"cpu_load": {
+				"node": "node_load1{hostname=~\"{{.Nodes}}\"}",
+				"hwthread": "rate(node_cpu_seconds_total{hostname=~\"{{.Nodes}}\"}[{{.Step}}])"
+			},
+			"mem_used": {
+				"node": "node_memory_Active_bytes{hostname=~\"{{.Nodes}}\"}"
+			}
+		}
+	}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	return pdb
+}
+
+func TestPrometheusLoadData(t *testing.T) {
+	server := fakePrometheus(t)
+	defer server.Close()
+	pdb := setupPrometheus(t, server.URL)
+
+	job := &schema.Job{BaseJob: schema.BaseJob{
+		Cluster:    "emmy",
+		SubCluster: "main",
+		NumNodes:   2,
+		Resources: []*schema.Resource{
+			{Hostname: "e0101"},
+			{Hostname: "e0102", HWThreads: []int{1}},
+		},
+	}}
+	job.StartTime = time.Unix(1600000000, 0)
+	job.Duration = 300
+
+	scopes := []schema.MetricScope{schema.MetricScopeNode, schema.MetricScopeHWThread}
+	jobData, err := pdb.LoadData(job, []string{"cpu_load", "flops_any"}, scopes, context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	node := jobData["cpu_load"][schema.MetricScopeNode]
+	if node == nil || len(node.Series) != 2 || node.Timestep != 60 {
+		t.Fatalf("unexpected node scope data: %#v", node)
+	}
+	series := node.Series[0]
+	if series.Hostname != "e0101" || series.Id != nil || len(series.Data) != 6 || !series.Data[0].IsNaN() || series.Data[5] != 5 {
+		t.Errorf("unexpected series: %#v", series)
+	}
+	if series.Statistics.Min != 1 || series.Statistics.Max != 5 || series.Statistics.Avg != 3 {
+		t.Errorf("unexpected statistics: %#v", series.Statistics)
+	}
+
+	// e0102 only has hwthread 1 assigned.
+	hwthread := jobData["cpu_load"][schema.MetricScopeHWThread]
+	if hwthread == nil || len(hwthread.Series) != 3 {
+		t.Fatalf("unexpected hwthread scope data: %#v", hwthread)
+	}
+	if s := hwthread.Series[2]; s.Hostname != "e0102" || s.Id == nil || *s.Id != 1 {
+		t.Errorf("unexpected series: %#v", s)
+	}
+
+	// No template for flops_any:
+	if _, ok := jobData["flops_any"]; ok {
+		t.Errorf("expected no data for flops_any")
+	}
+
+	stats, err := pdb.LoadStats(job, []string{"cpu_load"}, context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, ok := stats["cpu_load"]["e0102"]; !ok || s.Max != 5 {
+		t.Errorf("unexpected stats: %#v", stats)
+	}
+}
+
+func TestPrometheusLoadNodeData(t *testing.T) {
+	server := fakePrometheus(t)
+	defer server.Close()
+	pdb := setupPrometheus(t, server.URL)
+
+	from := time.Unix(1600000000, 0)
+	to := from.Add(2 * time.Minute)
+	data, err := pdb.LoadNodeData("emmy", []string{"cpu_load", "mem_used"}, []string{"e0101", "e0102"},
+		[]schema.MetricScope{schema.MetricScopeNode}, from, to, context.Background())
+
+	// mem_used fails, the cpu_load data should still be returned.
+	if err == nil || !strings.Contains(err.Error(), "unknown metric") {
+		t.Errorf("expected a partial error, got: %v", err)
+	}
+	if len(data) != 2 {
+		t.Fatalf("expected data for two nodes, got: %#v", data)
+	}
+
+	metrics := data["e0102"]["cpu_load"]
+	if len(metrics) != 1 || metrics[0].Scope != schema.MetricScopeNode || len(metrics[0].Series) != 1 {
+		t.Fatalf("unexpected data: %#v", metrics)
+	}
+	if s := metrics[0].Series[0]; len(s.Data) != 3 || s.Data[2] != 2 {
+		t.Errorf("unexpected series: %#v", s)
+	}
+	if _, ok := data["e0101"]["mem_used"]; ok {
+		t.Errorf("expected no data for mem_used")
+	}
+}
+
+func TestPrometheusEscapeHostnames(t *testing.T) {
+	server := fakePrometheus(t)
+	defer server.Close()
+	pdb := setupPrometheus(t, server.URL)
+
+	nodes := []string{"e0101.cluster", `e01"02\`}
+	if regex := pdb.nodesRegex(nodes); regex != `e0101\\.cluster|e01\"02\\\\` {
+		t.Errorf("unexpected regex: %s", regex)
+	}
+
+	from := time.Unix(1600000000, 0)
+	data, err := pdb.LoadNodeData("emmy", []string{"cpu_load"}, nodes,
+		[]schema.MetricScope{schema.MetricScopeNode}, from, from.Add(2*time.Minute), context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, node := range nodes {
+		if metrics := data[node]["cpu_load"]; len(metrics) != 1 || len(metrics[0].Series) != 1 {
+			t.Errorf("unexpected data for %s: %#v", node, data[node])
+		}
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"math"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+// resampleJobData reduces every metric of `jobData` to at most `resolution` points per series.
+// Metrics that are replaced get a new map per metric, so maps shared with a cache are not modified.
+func resampleJobData(jobData schema.JobData, resolution int) {
+	if resolution <= 0 {
+		return
+	}
+
+	for metric, scopes := range jobData {
+		resampled := make(map[schema.MetricScope]*schema.JobMetric, len(scopes))
+		for scope, jm := range scopes {
+			resampled[scope] = resampleJobMetric(jm, resolution)
+		}
+		jobData[metric] = resampled
+	}
+}
+
+// resampleJobMetric returns a copy of `jm` with at most `resolution` points per series. Every
+// `factor` values are replaced by one, the timestep is multiplied by `factor`. The series are
+// reduced using the Largest-Triangle-Three-Buckets algorithm, which keeps peaks and dips visible,
+// the min/max of the statistics series are the min/max of each bucket. Both use the same buckets,
+// so the i-th value always lies within the i-th timestep. The statistics of the series are kept
+// as they were calculated from the full resolution.
+func resampleJobMetric(jm *schema.JobMetric, resolution int) *schema.JobMetric {
+	length := 0
+	for _, series := range jm.Series {
+		if len(series.Data) > length {
+			length = len(series.Data)
+		}
+	}
+	if resolution <= 0 || jm.Timestep <= 0 || length <= resolution {
+		return jm
+	}
+
+	factor := (length + resolution - 1) / resolution
+	res := &schema.JobMetric{
+		Unit:     jm.Unit,
+		Scope:    jm.Scope,
+		Timestep: jm.Timestep * factor,
+		Series:   make([]schema.Series, 0, len(jm.Series)),
+	}
+
+	for _, series := range jm.Series {
+		series.Data = lttb(series.Data, factor)
+		res.Series = append(res.Series, series)
+	}
+
+	if ss := jm.StatisticsSeries; ss != nil {
+		res.StatisticsSeries = &schema.StatsSeries{
+			Mean: reduceBuckets(ss.Mean, factor, bucketAvg),
+			Min:  reduceBuckets(ss.Min, factor, bucketMin),
+			Max:  reduceBuckets(ss.Max, factor, bucketMax),
+		}
+		if ss.Percentiles != nil {
+			res.StatisticsSeries.Percentiles = make(map[int][]schema.Float, len(ss.Percentiles))
+			for p, data := range ss.Percentiles {
+				res.StatisticsSeries.Percentiles[p] = reduceBuckets(data, factor, bucketAvg)
+			}
+		}
+	}
+
+	return res
+}
+
+// lttb selects one point from every bucket of `factor` values of `data` using the
+// Largest-Triangle-Three-Buckets algorithm (Sveinn Steinarsson, 2013): The first and the last
+// point are kept, from every bucket in between, the point forming the largest triangle with the
+// previously selected point and the average of the next bucket is selected. The buckets start at
+// multiples of `factor` like the ones of reduceBuckets. NaN values are never selected unless a
+// bucket does not contain anything else.
+func lttb(data []schema.Float, factor int) []schema.Float {
+	n := len(data)
+	if factor <= 1 || n <= factor {
+		return reduceBuckets(data, factor, bucketAvg)
+	}
+
+	buckets := (n + factor - 1) / factor
+	if buckets < 3 {
+		return reduceBuckets(data, factor, bucketAvg)
+	}
+
+	res := make([]schema.Float, 0, buckets)
+	res = append(res, data[0])
+
+	a := 0 // The point selected from the previous bucket
+	for i := 1; i < buckets-1; i++ {
+		start, end := i*factor, (i+1)*factor
+
+		// Average of the next bucket (the last point for the last bucket).
+		nextStart, nextEnd := end, end+factor
+		if i == buckets-2 {
+			nextStart, nextEnd = n-1, n
+		}
+		avgX, avgY, count := 0.0, 0.0, 0
+		for j := nextStart; j < nextEnd; j++ {
+			if !data[j].IsNaN() {
+				avgX += float64(j)
+				avgY += float64(data[j])
+				count += 1
+			}
+		}
+		if count == 0 {
+			avgX, avgY = float64(nextStart+nextEnd-1)/2, math.NaN()
+		} else {
+			avgX, avgY = avgX/float64(count), avgY/float64(count)
+		}
+
+		ax, ay := float64(a), float64(data[a])
+		if math.IsNaN(ay) {
+			ay = avgY
+		}
+		if math.IsNaN(avgY) {
+			avgY = ay
+		}
+
+		selected, maxArea := -1, -1.0
+		for j := start; j < end; j++ {
+			if data[j].IsNaN() {
+				continue
+			}
+
+			y := float64(data[j])
+			area := math.Abs((ax-avgX)*(y-ay) - (ax-float64(j))*(avgY-ay))
+			if math.IsNaN(area) {
+				area = 0
+			}
+			if area > maxArea {
+				selected, maxArea = j, area
+			}
+		}
+
+		if selected == -1 {
+			res = append(res, schema.NaN)
+			continue
+		}
+		res = append(res, data[selected])
+		a = selected
+	}
+
+	return append(res, data[n-1])
+}
+
+// reduceBuckets replaces every `factor` values by one value calculated by `reduce`.
+func reduceBuckets(data []schema.Float, factor int, reduce func([]schema.Float) schema.Float) []schema.Float {
+	if data == nil || factor <= 1 {
+		return data
+	}
+
+	res := make([]schema.Float, 0, (len(data)+factor-1)/factor)
+	for i := 0; i < len(data); i += factor {
+		end := i + factor
+		if end > len(data) {
+			end = len(data)
+		}
+		res = append(res, reduce(data[i:end]))
+	}
+	return res
+}
+
+func bucketAvg(bucket []schema.Float) schema.Float {
+	sum, n := 0.0, 0
+	for _, x := range bucket {
+		if !x.IsNaN() {
+			sum += float64(x)
+			n += 1
+		}
+	}
+	if n == 0 {
+		return schema.NaN
+	}
+	return schema.Float(sum / float64(n))
+}
+
+func bucketMin(bucket []schema.Float) schema.Float {
+	min := schema.NaN
+	for _, x := range bucket {
+		if !x.IsNaN() && (min.IsNaN() || x < min) {
+			min = x
+		}
+	}
+	return min
+}
+
+func bucketMax(bucket []schema.Float) schema.Float {
+	max := schema.NaN
+	for _, x := range bucket {
+		if !x.IsNaN() && (max.IsNaN() || x > max) {
+			max = x
+		}
+	}
+	return max
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"testing"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+func TestLTTB(t *testing.T) {
+	data := make([]schema.Float, 100)
+	for i := range data {
+		data[i] = 1
+	}
+	data[42] = 100 // A peak
+	data[77] = -50 // A dip
+	data[13] = schema.NaN
+
+	res := lttb(data, 10)
+	if len(res) != 10 || res[0] != 1 || res[9] != 1 {
+		t.Fatalf("unexpected result: %v", res)
+	}
+
+	peak, dip := false, false
+	for _, x := range res {
+		if x == 100 {
+			peak = true
+		}
+		if x == -50 {
+			dip = true
+		}
+		if x.IsNaN() {
+			t.Errorf("NaN selected: %v", res)
+		}
+	}
+	if !peak || !dip {
+		t.Errorf("peak or dip lost: %v", res)
+	}
+
+	// The points stay within their buckets of 10 values:
+	if res[4] != 100 || res[7] != -50 {
+		t.Errorf("peak or dip moved to another bucket: %v", res)
+	}
+
+	// Buckets with NaN only stay NaN:
+	for i := 20; i < 40; i++ {
+		data[i] = schema.NaN
+	}
+	if res := lttb(data, 10); !res[3].IsNaN() {
+		t.Errorf("expected NaN for an empty bucket: %v", res)
+	}
+}
+
+func TestResampleJobMetric(t *testing.T) {
+	jm := &schema.JobMetric{
+		Scope:    schema.MetricScopeNode,
+		Timestep: 60,
+		Series: []schema.Series{
+			{Hostname: "a", Data: make([]schema.Float, 1000), Statistics: &schema.MetricStatistics{Avg: 1, Min: 0, Max: 2}},
+			{Hostname: "b", Data: make([]schema.Float, 995)},
+		},
+	}
+	jm.StatisticsSeries = &schema.StatsSeries{Mean: jm.Series[0].Data, Min: jm.Series[0].Data, Max: jm.Series[0].Data}
+
+	res := resampleJobMetric(jm, 300)
+	if res.Timestep != 240 || len(res.Series[0].Data) != 250 || len(res.Series[1].Data) != 249 {
+		t.Fatalf("unexpected result: timestep=%d, len=%d/%d", res.Timestep, len(res.Series[0].Data), len(res.Series[1].Data))
+	}
+	if res.Series[0].Statistics != jm.Series[0].Statistics {
+		t.Errorf("the statistics of the full resolution should be kept")
+	}
+	if len(res.StatisticsSeries.Min) != 250 || len(jm.StatisticsSeries.Min) != 1000 || len(jm.Series[0].Data) != 1000 {
+		t.Errorf("unexpected statistics series or original data modified")
+	}
+
+	if res := resampleJobMetric(jm, 1000); res != jm {
+		t.Errorf("expected the data to be unchanged")
+	}
+}
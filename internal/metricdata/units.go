@@ -0,0 +1,282 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	"github.com/ClusterCockpit/cc-backend/pkg/units"
+)
+
+// A UnitConverter wraps a metric data repository that does not store the metrics in the
+// units configured in the cluster.json. The units used by the repository are declared
+// in its config, e.g. `"units": { "mem_bw": "MB/s" }`. All data returned by the repository
+// is converted into the configured unit.
+type UnitConverter struct {
+	MetricDataRepository
+
+	units       map[string]units.Unit
+	conversions sync.Map // "<cluster>/<metric>" -> func(float64) float64 (nil if nothing to do)
+}
+
+// newUnitConverter wraps `repo` if its config declares units.
+func newUnitConverter(repo MetricDataRepository, rawConfig json.RawMessage) (MetricDataRepository, error) {
+	var config struct {
+		Units map[string]string `json:"units"`
+	}
+	if err := json.Unmarshal(rawConfig, &config); err != nil {
+		return nil, err
+	}
+	if len(config.Units) == 0 {
+		return repo, nil
+	}
+
+	uc := &UnitConverter{MetricDataRepository: repo, units: make(map[string]units.Unit, len(config.Units))}
+	for metric, unit := range config.Units {
+		u := units.NewUnit(unit)
+		if !u.Valid() {
+			return nil, fmt.Errorf("unknown unit '%s' for metric '%s'", unit, metric)
+		}
+		uc.units[metric] = u
+	}
+	return uc, nil
+}
+
+// validateMetricUnits checks the units of all metrics configured in the cluster.json files.
+// Rates like `GB/s` have to be known to pkg/units, as they are converted. Other units that are
+// not known, like `load` or `IPC`, are labels of dimensionless metrics and are never converted.
+func validateMetricUnits() error {
+	for _, cluster := range archive.Clusters {
+		for _, mc := range cluster.MetricConfig {
+			if mc.Unit == "" || units.NewUnit(mc.Unit).Valid() {
+				continue
+			}
+			if strings.Contains(mc.Unit, "/") {
+				return fmt.Errorf("metric '%s' of cluster '%s': unknown unit '%s'", mc.Name, cluster.Name, mc.Unit)
+			}
+			log.Debugf("metric '%s' of cluster '%s': unit '%s' is not converted", mc.Name, cluster.Name, mc.Unit)
+		}
+	}
+	return nil
+}
+
+// validateUnits checks that every unit declared by the repository of `cluster` (or by any of the
+// repositories of a failover chain) belongs to a metric of the cluster and can be converted into
+// the configured unit of that metric.
+func validateUnits(cluster string, repo MetricDataRepository) error {
+	switch r := repo.(type) {
+	case *FailoverRepository:
+		for _, b := range r.backends {
+			if err := validateUnits(cluster, b.repo); err != nil {
+				return fmt.Errorf("%s: %w", b.name, err)
+			}
+		}
+	case *UnitConverter:
+		for metric := range r.units {
+			if archive.GetMetricConfig(cluster, metric) == nil {
+				return fmt.Errorf("unit declared for unknown metric '%s'", metric)
+			}
+			if _, err := r.conversion(cluster, metric); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// conversion returns the function that converts values of `metric` from the unit of the
+// repository into the configured unit, nil if no conversion is needed.
+func (uc *UnitConverter) conversion(cluster, metric string) (func(float64) float64, error) {
+	key := cluster + "/" + metric
+	if conv, ok := uc.conversions.Load(key); ok {
+		return conv.(func(float64) float64), nil
+	}
+
+	from, ok := uc.units[metric]
+	if !ok {
+		return nil, nil
+	}
+
+	mc := archive.GetMetricConfig(cluster, metric)
+	if mc == nil {
+		return nil, nil
+	}
+
+	conv, err := unitConversion(from, mc.Unit)
+	if err != nil {
+		return nil, fmt.Errorf("metric '%s' of cluster '%s': %w", metric, cluster, err)
+	}
+	uc.conversions.Store(key, conv)
+	return conv, nil
+}
+
+// unitConversion returns the function converting values from `from` into the unit `to`,
+// nil if both are the same.
+func unitConversion(from units.Unit, to string) (func(float64) float64, error) {
+	out := units.NewUnit(to)
+	if !out.Valid() {
+		return nil, fmt.Errorf("unknown unit '%s'", to)
+	}
+	if from.Short() == out.Short() {
+		return nil, nil
+	}
+
+	f, err := units.GetUnitUnitFactor(from, out)
+	if err != nil {
+		return nil, fmt.Errorf("cannot convert '%s' into '%s'", from.Short(), out.Short())
+	}
+	return func(x float64) float64 {
+		return f(x).(float64)
+	}, nil
+}
+
+func (uc *UnitConverter) LoadData(
+	job *schema.Job,
+	metrics []string,
+	scopes []schema.MetricScope,
+	ctx context.Context) (schema.JobData, error) {
+
+	jobData, err := uc.MetricDataRepository.LoadData(job, metrics, scopes, ctx)
+	for metric, data := range jobData {
+		if conv, _ := uc.conversion(job.Cluster, metric); conv != nil {
+			for scope, jm := range data {
+				data[scope] = convertJobMetric(jm, conv, jm.Unit)
+			}
+		}
+	}
+	return jobData, err
+}
+
+func (uc *UnitConverter) LoadStats(
+	job *schema.Job,
+	metrics []string,
+	ctx context.Context) (map[string]map[string]schema.MetricStatistics, error) {
+
+	stats, err := uc.MetricDataRepository.LoadStats(job, metrics, ctx)
+	for metric, nodes := range stats {
+		if conv, _ := uc.conversion(job.Cluster, metric); conv != nil {
+			for node, s := range nodes {
+				nodes[node] = convertStatistics(s, conv)
+			}
+		}
+	}
+	return stats, err
+}
+
+func (uc *UnitConverter) LoadNodeData(
+	cluster string,
+	metrics, nodes []string,
+	scopes []schema.MetricScope,
+	from, to time.Time,
+	ctx context.Context) (map[string]map[string][]*schema.JobMetric, error) {
+
+	data, err := uc.MetricDataRepository.LoadNodeData(cluster, metrics, nodes, scopes, from, to, ctx)
+	for _, hostdata := range data {
+		for metric, jms := range hostdata {
+			if conv, _ := uc.conversion(cluster, metric); conv != nil {
+				for i, jm := range jms {
+					jms[i] = convertJobMetric(jm, conv, jm.Unit)
+				}
+			}
+		}
+	}
+	return data, err
+}
+
+// convertArchivedUnits converts metrics that were archived in a different unit than the one
+// currently configured. The maps per metric are replaced, so the archived data is not modified.
+func convertArchivedUnits(cluster string, jobData schema.JobData) {
+	for metric, data := range jobData {
+		mc := archive.GetMetricConfig(cluster, metric)
+		if mc == nil {
+			continue
+		}
+
+		converted := make(map[schema.MetricScope]*schema.JobMetric, len(data))
+		for scope, jm := range data {
+			converted[scope] = jm
+			if jm.Unit == "" || jm.Unit == mc.Unit {
+				continue
+			}
+
+			from := units.NewUnit(jm.Unit)
+			if !from.Valid() {
+				continue
+			}
+			conv, err := unitConversion(from, mc.Unit)
+			if err != nil {
+				log.Warnf("archived data of metric '%s' of cluster '%s': %s", metric, cluster, err.Error())
+				continue
+			}
+			if conv != nil {
+				converted[scope] = convertJobMetric(jm, conv, mc.Unit)
+			}
+		}
+		jobData[metric] = converted
+	}
+}
+
+// convertJobMetric returns a copy of `jm` with all values converted.
+func convertJobMetric(jm *schema.JobMetric, conv func(float64) float64, unit string) *schema.JobMetric {
+	res := &schema.JobMetric{
+		Unit:     unit,
+		Scope:    jm.Scope,
+		Timestep: jm.Timestep,
+		Series:   make([]schema.Series, 0, len(jm.Series)),
+	}
+
+	for _, series := range jm.Series {
+		series.Data = convertFloats(series.Data, conv)
+		if series.Statistics != nil {
+			stats := convertStatistics(*series.Statistics, conv)
+			series.Statistics = &stats
+		}
+		res.Series = append(res.Series, series)
+	}
+
+	if ss := jm.StatisticsSeries; ss != nil {
+		res.StatisticsSeries = &schema.StatsSeries{
+			Mean: convertFloats(ss.Mean, conv),
+			Min:  convertFloats(ss.Min, conv),
+			Max:  convertFloats(ss.Max, conv),
+		}
+		if ss.Percentiles != nil {
+			res.StatisticsSeries.Percentiles = make(map[int][]schema.Float, len(ss.Percentiles))
+			for p, data := range ss.Percentiles {
+				res.StatisticsSeries.Percentiles[p] = convertFloats(data, conv)
+			}
+		}
+	}
+
+	return res
+}
+
+func convertStatistics(s schema.MetricStatistics, conv func(float64) float64) schema.MetricStatistics {
+	return schema.MetricStatistics{Avg: conv(s.Avg), Min: conv(s.Min), Max: conv(s.Max)}
+}
+
+func convertFloats(data []schema.Float, conv func(float64) float64) []schema.Float {
+	if data == nil {
+		return nil
+	}
+
+	res := make([]schema.Float, len(data))
+	for i, x := range data {
+		if x.IsNaN() {
+			res[i] = schema.NaN
+		} else {
+			res[i] = schema.Float(conv(float64(x)))
+		}
+	}
+	return res
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricdata
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+func TestUnitConverter(t *testing.T) {
+	if err := archive.Init(json.RawMessage(`{"kind": "file","path": "../../test/archive"}`), false); err != nil {
+		t.Fatal(err)
+	}
+
+	// mem_used is configured in GB, mem_bw in GB/s.
+	repo, err := newMetricDataRepository(json.RawMessage(`{"kind": "test", "units": {"mem_used": "MB", "mem_bw": "GB/s"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := validateUnits("emmy", repo); err != nil {
+		t.Fatal(err)
+	}
+
+	TestLoadDataCallback = func(job *schema.Job, metrics []string, scopes []schema.MetricScope, ctx context.Context) (schema.JobData, error) {
+		series := []schema.Series{{
+			Hostname:   "e0101",
+			Statistics: &schema.MetricStatistics{Avg: 1500, Min: 1000, Max: 2000},
+			Data:       []schema.Float{1000, schema.NaN, 2000},
+		}}
+		return schema.JobData{
+			"mem_used": {schema.MetricScopeNode: &schema.JobMetric{Unit: "GB", Scope: schema.MetricScopeNode, Series: series}},
+			"mem_bw":   {schema.MetricScopeNode: &schema.JobMetric{Unit: "GB/s", Scope: schema.MetricScopeNode, Series: series}},
+		}, nil
+	}
+
+	jobData, err := repo.LoadData(&schema.Job{BaseJob: schema.BaseJob{Cluster: "emmy"}}, nil, nil, context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := jobData["mem_used"][schema.MetricScopeNode].Series[0]
+	if s.Data[0] != 1 || !s.Data[1].IsNaN() || s.Data[2] != 2 {
+		t.Errorf("unexpected data: %#v", s.Data)
+	}
+	if s.Statistics.Avg != 1.5 || s.Statistics.Min != 1 || s.Statistics.Max != 2 {
+		t.Errorf("unexpected statistics: %#v", s.Statistics)
+	}
+
+	// Same unit, nothing to convert:
+	if s := jobData["mem_bw"][schema.MetricScopeNode].Series[0]; s.Data[0] != 1000 || s.Statistics.Avg != 1500 {
+		t.Errorf("unexpected data: %#v", s.Data)
+	}
+
+	for _, config := range []string{
+		`{"kind": "test", "units": {"mem_used": "foobar"}}`,
+		`{"kind": "test", "units": {"mem_used": "GB/s"}}`,
+		`{"kind": "test", "units": {"cpu_load": "GB"}}`,
+		`{"kind": "test", "units": {"no_such_metric": "GB"}}`,
+		`[{"kind": "test"}, {"kind": "test", "units": {"mem_bw": "GHz"}}]`,
+	} {
+		repo, err := newMetricDataRepository(json.RawMessage(config))
+		if err == nil {
+			err = validateUnits("emmy", repo)
+		}
+		if err == nil {
+			t.Errorf("expected an error for config: %s", config)
+		}
+	}
+}
+
+func TestConvertArchivedUnits(t *testing.T) {
+	if err := archive.Init(json.RawMessage(`{"kind": "file","path": "../../test/archive"}`), false); err != nil {
+		t.Fatal(err)
+	}
+
+	archived := map[schema.MetricScope]*schema.JobMetric{
+		schema.MetricScopeNode: {Unit: "MB", Scope: schema.MetricScopeNode, Series: []schema.Series{{Hostname: "e0101", Data: []schema.Float{2000}}}},
+	}
+	jobData := schema.JobData{"mem_used": archived}
+	convertArchivedUnits("emmy", jobData)
+
+	jm := jobData["mem_used"][schema.MetricScopeNode]
+	if jm.Unit != "GB" || jm.Series[0].Data[0] != 2 {
+		t.Errorf("unexpected result: %#v", jm)
+	}
+	if archived[schema.MetricScopeNode].Series[0].Data[0] != 2000 {
+		t.Errorf("the archived data must not be modified")
+	}
+}
+
+func TestValidateMetricUnits(t *testing.T) {
+	if err := archive.Init(json.RawMessage(`{"kind": "file","path": "../../test/archive"}`), false); err != nil {
+		t.Fatal(err)
+	}
+
+	// emmy uses dimensionless units like `load` and `IPC`:
+	if err := validateMetricUnits(); err != nil {
+		t.Fatal(err)
+	}
+
+	cluster := &schema.Cluster{
+		Name:         "units-test",
+		MetricConfig: []*schema.MetricConfig{{Name: "net_bw", Unit: "XB/s", Scope: schema.MetricScopeNode}},
+	}
+	archive.Clusters = append(archive.Clusters, cluster)
+	defer func() {
+		archive.Clusters = archive.Clusters[:len(archive.Clusters)-1]
+	}()
+
+	if err := validateMetricUnits(); err == nil {
+		t.Errorf("expected an error for the unit '%s'", cluster.MetricConfig[0].Unit)
+	}
+
+	cluster.MetricConfig[0].Unit = "MB/s"
+	if err := validateMetricUnits(); err != nil {
+		t.Error(err)
+	}
+}
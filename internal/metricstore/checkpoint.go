@@ -0,0 +1,212 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricstore
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+// The checkpoint of one node, saved as `<checkpoint-dir>/<cluster>/<hostname>.json`.
+type checkpointFile struct {
+	Timestamp int64               `json:"timestamp"`
+	Series    []*checkpointSeries `json:"series"`
+}
+
+type checkpointSeries struct {
+	Metric   string             `json:"metric"`
+	Type     schema.MetricScope `json:"type"`
+	TypeId   string             `json:"type-id,omitempty"`
+	Timestep int64              `json:"timestep"`
+	Start    int64              `json:"start"` // Timestamp of the first value
+	Data     []checkpointFloat  `json:"data"`
+}
+
+// Unlike schema.Float, all digits are kept. NaN is saved as null.
+type checkpointFloat float64
+
+func (f checkpointFloat) MarshalJSON() ([]byte, error) {
+	if math.IsNaN(float64(f)) {
+		return []byte("null"), nil
+	}
+	return strconv.AppendFloat(make([]byte, 0, 10), float64(f), 'g', -1, 64), nil
+}
+
+func (f *checkpointFloat) UnmarshalJSON(input []byte) error {
+	if string(input) == "null" {
+		*f = checkpointFloat(math.NaN())
+		return nil
+	}
+
+	x, err := strconv.ParseFloat(string(input), 64)
+	if err != nil {
+		return err
+	}
+	*f = checkpointFloat(x)
+	return nil
+}
+
+// checkpoint saves the data of every node. The files of nodes without data are removed.
+func checkpoint(dir string, now time.Time) error {
+	clustersLock.RLock()
+	nodes := make(map[string]*node)
+	for cluster, byHost := range clusters {
+		for host, n := range byHost {
+			nodes[filepath.Join(cluster, host+".json")] = n
+		}
+	}
+	clustersLock.RUnlock()
+
+	for path, n := range nodes {
+		if err := writeCheckpoint(filepath.Join(dir, path), n.checkpoint(now)); err != nil {
+			return err
+		}
+	}
+
+	// Nodes that were freed:
+	files, err := filepath.Glob(filepath.Join(dir, "*", "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+		if _, ok := nodes[rel]; !ok {
+			if err := os.Remove(file); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (n *node) checkpoint(now time.Time) *checkpointFile {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+
+	cp := &checkpointFile{Timestamp: now.Unix(), Series: make([]*checkpointSeries, 0)}
+	for metric, byId := range n.metrics {
+		for typeId, b := range byId {
+			if b.empty {
+				continue
+			}
+
+			size := int64(len(b.data))
+			slot := b.newest - size + 1
+			if slot < 0 {
+				slot = 0
+			}
+			// Leading missing values are not saved:
+			for slot < b.newest && b.data[slot%size].IsNaN() {
+				slot++
+			}
+
+			s := &checkpointSeries{
+				Metric:   metric,
+				Type:     b.scope,
+				TypeId:   typeId,
+				Timestep: b.timestep,
+				Start:    slot * b.timestep,
+				Data:     make([]checkpointFloat, 0, b.newest-slot+1),
+			}
+			for ; slot <= b.newest; slot++ {
+				s.Data = append(s.Data, checkpointFloat(b.data[slot%size]))
+			}
+			cp.Series = append(cp.Series, s)
+		}
+	}
+	return cp
+}
+
+// writeCheckpoint replaces the file atomically so that a crash does not leave a partial file behind.
+func writeCheckpoint(path string, cp *checkpointFile) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(f)
+	err = json.NewEncoder(bw).Encode(cp)
+	if err == nil {
+		err = bw.Flush()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+// restore loads all checkpoints, values older than the retention are skipped.
+// It returns the number of values restored.
+func restore(dir string, now time.Time) (int, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*", "*.json"))
+	if err != nil {
+		return 0, err
+	}
+
+	oldest := now.Add(-retention).Unix()
+	restored := 0
+	for _, file := range files {
+		cluster := filepath.Base(filepath.Dir(file))
+		host := strings.TrimSuffix(filepath.Base(file), ".json")
+
+		f, err := os.Open(file)
+		if err != nil {
+			return restored, err
+		}
+
+		cp := &checkpointFile{}
+		err = json.NewDecoder(bufio.NewReader(f)).Decode(cp)
+		f.Close()
+		if err != nil {
+			log.Warnf("metric-store: skipping checkpoint '%s': %s", file, err.Error())
+			continue
+		}
+
+		for _, s := range cp.Series {
+			if s.Timestep <= 0 {
+				return restored, fmt.Errorf("%s: invalid timestep %d", file, s.Timestep)
+			}
+
+			typ := string(s.Type)
+			for i, x := range s.Data {
+				ts := s.Start + int64(i)*s.Timestep
+				if ts < oldest || math.IsNaN(float64(x)) {
+					continue
+				}
+				if insert(cluster, host, s.Metric, typ, s.TypeId, ts, schema.Float(x)) {
+					restored += 1
+				}
+			}
+		}
+	}
+
+	return restored, nil
+}
@@ -0,0 +1,458 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Package metricstore is a small in-memory store for metric data, an alternative to running a
+// cc-metric-store for small clusters. Data is ingested as InfluxDB line protocol (see Write) and
+// every series (cluster, host, type, type-id and metric) is kept in a ring buffer that covers the
+// configured retention at the timestep of the metric from the cluster.json. The data is saved to
+// checkpoints so that it survives restarts. The metric data repository kind `embedded` reads from
+// this store.
+package metricstore
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	protocol "github.com/influxdata/line-protocol"
+)
+
+var (
+	enabled            bool
+	retention          time.Duration = 48 * time.Hour
+	checkpointDir      string
+	checkpointInterval time.Duration = time.Hour
+
+	// All nodes by cluster and hostname.
+	clusters     map[string]map[string]*node = map[string]map[string]*node{}
+	clustersLock sync.RWMutex
+
+	stop     chan struct{} = make(chan struct{})
+	stopOnce sync.Once
+	done     sync.WaitGroup
+)
+
+// The series of one node by metric and type-id ("" for node level series).
+type node struct {
+	lock     sync.RWMutex
+	topology *schema.Topology
+	metrics  map[string]map[string]*buffer
+}
+
+// A ring buffer with one value per timestep. The value of the timestamp `ts`
+// is at index `(ts / timestep) % len(data)`.
+type buffer struct {
+	scope    schema.MetricScope
+	timestep int64
+	newest   int64 // Slot (timestamp / timestep) of the newest value
+	empty    bool
+	data     []schema.Float
+}
+
+// Init enables the store and restores the last checkpoint. The metric configuration of the
+// clusters (package archive) has to be initialized first.
+func Init(config *schema.MetricStoreConfig) error {
+	if config.Retention != "" {
+		d, err := time.ParseDuration(config.Retention)
+		if err != nil {
+			return fmt.Errorf("metric-store: invalid retention: %w", err)
+		}
+		retention = d
+	}
+	if config.CheckpointInterval != "" {
+		d, err := time.ParseDuration(config.CheckpointInterval)
+		if err != nil {
+			return fmt.Errorf("metric-store: invalid checkpoint interval: %w", err)
+		}
+		checkpointInterval = d
+	}
+	if retention <= 0 || checkpointInterval <= 0 {
+		return errors.New("metric-store: the retention and checkpoint interval must be positive")
+	}
+
+	checkpointDir = config.CheckpointDir
+	if checkpointDir != "" {
+		start := time.Now()
+		n, err := restore(checkpointDir, start)
+		if err != nil {
+			return fmt.Errorf("metric-store: restoring the checkpoints failed: %w", err)
+		}
+		log.Infof("metric-store: restored %d values from '%s' in %s", n, checkpointDir, time.Since(start))
+	}
+
+	enabled = true
+	done.Add(1)
+	go func() {
+		defer done.Done()
+		ticker := time.NewTicker(checkpointInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case now := <-ticker.C:
+				n := free(now)
+				if n > 0 {
+					log.Infof("metric-store: freed %d series without data in the last %s", n, retention)
+				}
+				if checkpointDir != "" {
+					if err := checkpoint(checkpointDir, now); err != nil {
+						log.Errorf("metric-store: writing checkpoints failed: %s", err.Error())
+					}
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Enabled returns true if Init was called.
+func Enabled() bool {
+	return enabled
+}
+
+// Shutdown writes a last checkpoint.
+func Shutdown() {
+	if !enabled {
+		return
+	}
+
+	stopOnce.Do(func() { close(stop) })
+	done.Wait()
+	if checkpointDir != "" {
+		if err := checkpoint(checkpointDir, time.Now()); err != nil {
+			log.Errorf("metric-store: writing checkpoints failed: %s", err.Error())
+		}
+	}
+}
+
+// Write stores the metric data from `r` in InfluxDB line protocol with timestamps in the unit `precision`.
+// The measurement is the metric, the value has to be in the field `value`, and the tags `hostname`,
+// `type` and `type-id` are used like by the cc-metric-collector. `cluster` is used for lines without
+// a `cluster` tag. Values of metrics that are not configured for the cluster or not in the native
+// scope of the metric, of hosts that are not in the node list of a subcluster and of type-ids that
+// are not in the topology of the node are dropped. It returns the number of stored and dropped values.
+func Write(cluster string, r io.Reader, precision time.Duration) (int, int, error) {
+	parser := protocol.NewStreamParser(r)
+	parser.SetTimePrecision(precision)
+
+	stored, dropped := 0, 0
+	for {
+		m, err := parser.Next()
+		if err == protocol.EOF {
+			return stored, dropped, nil
+		} else if err != nil {
+			return stored, dropped, err
+		}
+
+		lineCluster, host, typ, typeId := cluster, "", "", ""
+		for _, tag := range m.TagList() {
+			switch tag.Key {
+			case "cluster":
+				lineCluster = tag.Value
+			case "hostname":
+				host = tag.Value
+			case "type":
+				typ = tag.Value
+			case "type-id":
+				typeId = tag.Value
+			}
+		}
+
+		var value schema.Float
+		ok := false
+		for _, field := range m.FieldList() {
+			if field.Key != "value" {
+				continue
+			}
+			switch x := field.Value.(type) {
+			case float64:
+				value, ok = schema.Float(x), true
+			case int64:
+				value, ok = schema.Float(x), true
+			case uint64:
+				value, ok = schema.Float(x), true
+			}
+		}
+
+		if ok && host != "" && insert(lineCluster, host, m.Name(), typ, typeId, m.Time().Unix(), value) {
+			stored += 1
+		} else {
+			dropped += 1
+		}
+	}
+}
+
+// insert stores a single value, false is returned if it was dropped.
+func insert(cluster, host, metric, typ, typeId string, ts int64, value schema.Float) bool {
+	mc := archive.GetMetricConfig(cluster, metric)
+	if mc == nil {
+		return false
+	}
+
+	if mc.Scope == schema.MetricScopeNode {
+		if typ != "" && typ != string(schema.MetricScopeNode) {
+			return false
+		}
+		typeId = ""
+	} else if typ != string(mc.Scope) || typeId == "" {
+		return false
+	}
+
+	n := getNode(cluster, host, true)
+	if n == nil {
+		return false
+	}
+	if typeId != "" {
+		var ok bool
+		if typeId, ok = topologyTypeId(n.topology, mc.Scope, typeId); !ok {
+			return false
+		}
+	}
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	byId, ok := n.metrics[metric]
+	if !ok {
+		byId = make(map[string]*buffer)
+		n.metrics[metric] = byId
+	}
+
+	b, ok := byId[typeId]
+	if !ok || b.scope != mc.Scope {
+		b = newBuffer(mc)
+		byId[typeId] = b
+	}
+	return b.write(ts, value)
+}
+
+// getNode returns the node of a host. If `create` is true, a node is created for hosts in the
+// node list of a subcluster, nil is returned for other hosts.
+func getNode(cluster, host string, create bool) *node {
+	clustersLock.RLock()
+	n, ok := clusters[cluster][host]
+	clustersLock.RUnlock()
+	if ok || !create {
+		return n
+	}
+
+	// The hostname is used in the path of the checkpoint file.
+	if host == "" || strings.ContainsAny(host, `/\`) || strings.Contains(host, "..") {
+		return nil
+	}
+	sc, err := archive.GetSubClusterByNode(cluster, host)
+	if err != nil {
+		return nil
+	}
+	subCluster := archive.GetSubCluster(cluster, sc)
+	if subCluster == nil {
+		return nil
+	}
+
+	clustersLock.Lock()
+	defer clustersLock.Unlock()
+	if n, ok := clusters[cluster][host]; ok {
+		return n
+	}
+
+	if _, ok := clusters[cluster]; !ok {
+		clusters[cluster] = make(map[string]*node)
+	}
+	n = &node{topology: subCluster.Topology, metrics: make(map[string]map[string]*buffer)}
+	clusters[cluster][host] = n
+	return n
+}
+
+// topologyTypeId returns the normalized type-id if it is in the topology: The ID of a hwthread or
+// the index of a core, socket or memory domain. Accelerators are accepted by index or ID.
+func topologyTypeId(topology *schema.Topology, scope schema.MetricScope, typeId string) (string, bool) {
+	if topology == nil {
+		return "", false
+	}
+	if scope == schema.MetricScopeAccelerator {
+		if _, ok := topology.GetAcceleratorIndex(typeId); ok {
+			return typeId, true
+		}
+	}
+
+	id, err := strconv.Atoi(typeId)
+	if err != nil || id < 0 {
+		return "", false
+	}
+
+	ok := false
+	switch scope {
+	case schema.MetricScopeHWThread:
+		for _, hwthread := range topology.Node {
+			ok = ok || hwthread == id
+		}
+	case schema.MetricScopeCore:
+		ok = id < len(topology.Core)
+	case schema.MetricScopeSocket:
+		ok = id < len(topology.Socket)
+	case schema.MetricScopeMemoryDomain:
+		ok = id < len(topology.MemoryDomain)
+	case schema.MetricScopeAccelerator:
+		ok = id < len(topology.Accelerators)
+	}
+	return strconv.Itoa(id), ok
+}
+
+func newBuffer(mc *schema.MetricConfig) *buffer {
+	timestep := int64(mc.Timestep)
+	if timestep <= 0 {
+		timestep = 60
+	}
+
+	size := int64(retention.Seconds()) / timestep
+	if size < 1 {
+		size = 1
+	}
+
+	return &buffer{
+		scope:    mc.Scope,
+		timestep: timestep,
+		empty:    true,
+		data:     make([]schema.Float, size),
+	}
+}
+
+// write stores the value of the timestamp `ts`, it returns false if the value is too old.
+func (b *buffer) write(ts int64, value schema.Float) bool {
+	slot, size := ts/b.timestep, int64(len(b.data))
+	switch {
+	case b.empty:
+		for i := range b.data {
+			b.data[i] = schema.NaN
+		}
+		b.newest, b.empty = slot, false
+	case slot > b.newest:
+		// Values that were not sent are missing:
+		for s := b.newest + 1; s < slot && s <= b.newest+size; s++ {
+			b.data[s%size] = schema.NaN
+		}
+		b.newest = slot
+	case slot <= b.newest-size:
+		return false
+	}
+
+	b.data[slot%size] = value
+	return true
+}
+
+// read returns the values from `from` to `to` with a value every timestep starting at `from`.
+// Values older than the retention are not kept: If `from` is older, the values start at the first
+// timestep within the retention, the returned timestamp is the one of the first value. Nothing is
+// returned if the buffer is empty or all values are older than the retention.
+func (b *buffer) read(from, to int64) (int64, []schema.Float) {
+	size := int64(len(b.data))
+	if b.empty {
+		return from, nil
+	}
+	if oldest := (b.newest - size + 1) * b.timestep; from < oldest {
+		from += (oldest - from + b.timestep - 1) / b.timestep * b.timestep
+	}
+	if from > to {
+		return from, nil
+	}
+
+	data := make([]schema.Float, (to-from)/b.timestep+1)
+	for i := range data {
+		slot := (from + int64(i)*b.timestep) / b.timestep
+		if slot > b.newest || slot <= b.newest-size {
+			data[i] = schema.NaN
+		} else {
+			data[i] = b.data[slot%size]
+		}
+	}
+	return from, data
+}
+
+// Series are the values of a metric of one node and type-id with a value every `Timestep`
+// seconds, the first one at `From`.
+type Series struct {
+	From     int64
+	Timestep int64
+	Data     []schema.Float
+}
+
+// Read returns the series of a metric of one node by type-id ("" for the node level series)
+// from `from` to `to` (Unix timestamps) with a value every timestep of the metric. The series
+// start at `from` or at the first timestep within the retention if `from` is older. Missing
+// values are NaN, series without values in the time range are left out.
+func Read(cluster, host, metric string, from, to int64) map[string]Series {
+	n := getNode(cluster, host, false)
+	if n == nil || to < from {
+		return nil
+	}
+
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+
+	series := make(map[string]Series, len(n.metrics[metric]))
+	for typeId, b := range n.metrics[metric] {
+		start, data := b.read(from, to)
+		if data != nil {
+			series[typeId] = Series{From: start, Timestep: b.timestep, Data: data}
+		}
+	}
+	return series
+}
+
+// Hosts returns the sorted hostnames of all nodes of the cluster with data.
+func Hosts(cluster string) []string {
+	clustersLock.RLock()
+	defer clustersLock.RUnlock()
+
+	hosts := make([]string, 0, len(clusters[cluster]))
+	for host := range clusters[cluster] {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	return hosts
+}
+
+// free removes all series without values in the retention period and returns their number.
+func free(now time.Time) int {
+	oldest := now.Add(-retention).Unix()
+	clustersLock.Lock()
+	defer clustersLock.Unlock()
+
+	freed := 0
+	for cluster, nodes := range clusters {
+		for host, n := range nodes {
+			n.lock.Lock()
+			for metric, byId := range n.metrics {
+				for typeId, b := range byId {
+					if b.empty || (b.newest+1)*b.timestep <= oldest {
+						delete(byId, typeId)
+						freed += 1
+					}
+				}
+				if len(byId) == 0 {
+					delete(n.metrics, metric)
+				}
+			}
+			if len(n.metrics) == 0 {
+				delete(nodes, host)
+			}
+			n.lock.Unlock()
+		}
+		if len(nodes) == 0 {
+			delete(clusters, cluster)
+		}
+	}
+	return freed
+}
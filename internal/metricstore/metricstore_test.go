@@ -0,0 +1,182 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package metricstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+func setup(t *testing.T) {
+	if err := archive.Init(json.RawMessage(`{"kind": "file","path": "../../test/archive"}`), false); err != nil {
+		t.Fatal(err)
+	}
+
+	clusters = map[string]map[string]*node{}
+	retention = 10 * time.Minute
+}
+
+func TestBuffer(t *testing.T) {
+	retention = 5 * time.Minute
+	b := newBuffer(&schema.MetricConfig{Timestep: 60})
+	if len(b.data) != 5 {
+		t.Fatalf("unexpected size: %d", len(b.data))
+	}
+
+	start := int64(1600000000)
+	for i := int64(0); i < 8; i++ {
+		if i == 6 {
+			continue
+		}
+		// Timestamps are not aligned:
+		b.write(start+i*60+7, schema.Float(i))
+	}
+	if b.write(start, 100) {
+		t.Error("expected the value to be too old")
+	}
+
+	// The first three values were overwritten and are not returned, the 7th is missing:
+	from, data := b.read(start, start+9*60)
+	expected := []string{"3", "4", "5", "NaN", "7", "NaN", "NaN"}
+	if from != start+3*60 || len(data) != len(expected) {
+		t.Fatalf("unexpected data: %d, %v", from-start, data)
+	}
+	for i, x := range data {
+		if fmt.Sprint(x) != expected[i] {
+			t.Fatalf("unexpected data: %v", data)
+		}
+	}
+
+	// Nothing is allocated for the time before the retention:
+	if from, data := b.read(0, start+9*60); len(data) != 7 || from%60 != 0 {
+		t.Errorf("unexpected data: %d, %v", from, data)
+	}
+	if _, data := b.read(0, start); data != nil {
+		t.Errorf("expected no data before the retention: %v", data)
+	}
+}
+
+func TestWriteAndCheckpoint(t *testing.T) {
+	setup(t)
+
+	now := time.Now()
+	start := now.Add(-5*time.Minute).Unix() / 60 * 60
+	lines := &strings.Builder{}
+	for i := int64(0); i < 5; i++ {
+		ts := start + i*60
+		fmt.Fprintf(lines, "mem_used,hostname=e0101,type=node value=%d %d\n", i, ts)
+		fmt.Fprintf(lines, "cpu_load,hostname=e0101,type=hwthread,type-id=3 value=0.125 %d\n", ts)
+		// Not in the native scope (hwthread) and not configured:
+		fmt.Fprintf(lines, "cpu_load,hostname=e0101 value=1 %d\n", ts)
+		fmt.Fprintf(lines, "unknown,hostname=e0101 value=1 %d\n", ts)
+	}
+	// Unknown cluster:
+	fmt.Fprintf(lines, "mem_used,cluster=fritz,hostname=f0101 value=1 %d\n", start)
+
+	stored, dropped, err := Write("emmy", strings.NewReader(lines.String()), time.Second)
+	if err != nil || stored != 10 || dropped != 11 {
+		t.Fatalf("unexpected result: %d stored, %d dropped (%v)", stored, dropped, err)
+	}
+
+	// The cluster tag takes precedence, timestamps in milliseconds:
+	stored, _, err = Write("fritz", strings.NewReader(fmt.Sprintf("mem_used,cluster=emmy,hostname=e0102 value=1 %d\n", start*1000)), time.Millisecond)
+	if err != nil || stored != 1 {
+		t.Fatalf("unexpected result: %d stored (%v)", stored, err)
+	}
+	if hosts := Hosts("emmy"); len(hosts) != 2 || hosts[1] != "e0102" {
+		t.Errorf("unexpected hosts: %v", hosts)
+	}
+
+	check := func() {
+		memUsed := Read("emmy", "e0101", "mem_used", start, start+240)
+		if len(memUsed) != 1 || memUsed[""].From != start || len(memUsed[""].Data) != 5 || memUsed[""].Data[4] != 4 {
+			t.Fatalf("unexpected mem_used data: %v", memUsed)
+		}
+		cpuLoad := Read("emmy", "e0101", "cpu_load", start, start+240)
+		if len(cpuLoad) != 1 || cpuLoad["3"].Data[0] != 0.125 {
+			t.Fatalf("unexpected cpu_load data: %v", cpuLoad)
+		}
+	}
+	check()
+
+	dir := t.TempDir()
+	if err := checkpoint(dir, now); err != nil {
+		t.Fatal(err)
+	}
+
+	clusters = map[string]map[string]*node{}
+	n, err := restore(dir, now)
+	if err != nil || n != 11 {
+		t.Fatalf("unexpected restore: %d values (%v)", n, err)
+	}
+	check()
+
+	// Nothing is left once the retention has passed:
+	if n := free(now.Add(retention)); n != 3 || len(Hosts("emmy")) != 0 {
+		t.Errorf("unexpected number of freed series: %d", n)
+	}
+}
+
+func TestWriteInvalidSeries(t *testing.T) {
+	setup(t)
+
+	ts := time.Now().Unix() / 60 * 60
+	lines := fmt.Sprintf(
+		// Hostnames that would escape the checkpoint directory:
+		"mem_used,hostname=../../../tmp/x value=1 %[1]d\n"+
+			"mem_used,hostname=e01..02 value=1 %[1]d\n"+
+			// Hwthread 40 does not exist, the type-id has to be numeric:
+			"cpu_load,hostname=e0101,type=hwthread,type-id=40 value=1 %[1]d\n"+
+			"cpu_load,hostname=e0101,type=hwthread,type-id=x value=1 %[1]d\n"+
+			// The type-id is normalized:
+			"cpu_load,hostname=e0101,type=hwthread,type-id=03 value=1 %[1]d\n", ts)
+	stored, dropped, err := Write("emmy", strings.NewReader(lines), time.Second)
+	if err != nil || stored != 1 || dropped != 4 {
+		t.Fatalf("unexpected result: %d stored, %d dropped (%v)", stored, dropped, err)
+	}
+	if cpuLoad := Read("emmy", "e0101", "cpu_load", ts, ts); len(cpuLoad) != 1 || cpuLoad["3"].Data[0] != 1 {
+		t.Errorf("unexpected cpu_load data: %v", cpuLoad)
+	}
+
+	parent := t.TempDir()
+	if err := checkpoint(filepath.Join(parent, "checkpoints"), time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if entries, err := os.ReadDir(parent); err != nil || len(entries) != 1 {
+		t.Errorf("unexpected files outside of the checkpoint directory: %v (%v)", entries, err)
+	}
+
+	// With a node list, values of other hosts are dropped:
+	config, err := os.ReadFile("../../test/archive/emmy/cluster.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	archiveDir := t.TempDir()
+	os.Mkdir(filepath.Join(archiveDir, "emmy"), 0755)
+	config = []byte(strings.Replace(string(config), `"name": "main",`, `"name": "main", "nodes": "e01[01-10]",`, 1))
+	if err := os.WriteFile(filepath.Join(archiveDir, "emmy", "cluster.json"), config, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := archive.Init(json.RawMessage(`{"kind": "file","path": "`+archiveDir+`"}`), false); err != nil {
+		t.Fatal(err)
+	}
+
+	lines = fmt.Sprintf("mem_used,hostname=e0110 value=1 %[1]d\nmem_used,hostname=e0111 value=1 %[1]d\n", ts)
+	stored, dropped, err = Write("emmy", strings.NewReader(lines), time.Second)
+	if err != nil || stored != 1 || dropped != 1 {
+		t.Fatalf("unexpected result: %d stored, %d dropped (%v)", stored, dropped, err)
+	}
+	if hosts := Hosts("emmy"); len(hosts) != 2 || hosts[1] != "e0110" {
+		t.Errorf("unexpected hosts: %v", hosts)
+	}
+}
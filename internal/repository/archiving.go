@@ -0,0 +1,263 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	archivingRepoOnce     sync.Once
+	archivingRepoInstance *ArchivingRepository
+)
+
+// The `archive_queue` table holds one entry per stopped job that still has to be archived
+// (or could not be archived). `job_id` is not a foreign key so that the `job` table can
+// be dropped and recreated by InitDB.
+const ArchivingDBSchema string = `
+	CREATE TABLE IF NOT EXISTS archive_queue (
+		id           INTEGER PRIMARY KEY /*!40101 AUTO_INCREMENT */,
+		job_id       INTEGER NOT NULL,
+		state        VARCHAR(255) NOT NULL CHECK(state IN ('pending', 'running', 'failed', 'abandoned')),
+		attempts     INT NOT NULL DEFAULT 0,
+		last_error   TEXT,
+		next_attempt BIGINT NOT NULL, -- Unix timestamp
+		created_at   BIGINT NOT NULL, -- Unix timestamp
+		CONSTRAINT archive_queue_unique UNIQUE (job_id));
+`
+
+type ArchivingRepository struct {
+	DB *sqlx.DB
+
+	stmtCache *sq.StmtCache
+}
+
+func GetArchivingRepository() *ArchivingRepository {
+	archivingRepoOnce.Do(func() {
+		db := GetConnection()
+
+		if _, err := db.DB.Exec(ArchivingDBSchema); err != nil {
+			log.Fatal(err)
+		}
+
+		archivingRepoInstance = &ArchivingRepository{
+			DB:        db.DB,
+			stmtCache: sq.NewStmtCache(db.DB),
+		}
+	})
+
+	return archivingRepoInstance
+}
+
+var archivingColumns []string = []string{
+	"archive_queue.id", "archive_queue.job_id", "archive_queue.state", "archive_queue.attempts",
+	"archive_queue.last_error", "archive_queue.next_attempt", "archive_queue.created_at",
+}
+
+func scanArchivingTask(row interface{ Scan(...interface{}) error }) (*schema.ArchivingTask, error) {
+	task := &schema.ArchivingTask{}
+	var lastError sql.NullString
+	if err := row.Scan(
+		&task.ID, &task.JobID, &task.State, &task.Attempts, &lastError, &task.NextAttemptUnix, &task.CreatedAtUnix); err != nil {
+		return nil, err
+	}
+
+	task.LastError = lastError.String
+	task.NextAttempt = time.Unix(task.NextAttemptUnix, 0)
+	task.CreatedAt = time.Unix(task.CreatedAtUnix, 0)
+	return task, nil
+}
+
+// FindTask returns the queue entry with the given id.
+// To check if no entry was found test err == sql.ErrNoRows
+func (r *ArchivingRepository) FindTask(id int64) (*schema.ArchivingTask, error) {
+	q := sq.Select(archivingColumns...).From("archive_queue").Where("archive_queue.id = ?", id)
+	return scanArchivingTask(q.RunWith(r.stmtCache).QueryRow())
+}
+
+// QueryTasks returns all queue entries, optionally only the ones in `state`, oldest first.
+func (r *ArchivingRepository) QueryTasks(state *schema.ArchivingState) ([]*schema.ArchivingTask, error) {
+	query := sq.Select(archivingColumns...).From("archive_queue").OrderBy("archive_queue.id ASC")
+	if state != nil {
+		query = query.Where("archive_queue.state = ?", *state)
+	}
+
+	rows, err := query.RunWith(r.stmtCache).Query()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tasks := make([]*schema.ArchivingTask, 0)
+	for rows.Next() {
+		task, err := scanArchivingTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, rows.Err()
+}
+
+// Enqueue adds a pending entry for the job. If the job already has an entry,
+// it is reset to pending.
+func (r *ArchivingRepository) Enqueue(jobId int64, now time.Time) error {
+	res, err := sq.Update("archive_queue").
+		Set("state", schema.ArchivingStatePending).
+		Set("attempts", 0).
+		Set("last_error", nil).
+		Set("next_attempt", now.Unix()).
+		Where("archive_queue.job_id = ?", jobId).
+		RunWith(r.stmtCache).Exec()
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil || n > 0 {
+		return err
+	}
+
+	_, err = sq.Insert("archive_queue").
+		Columns("job_id", "state", "attempts", "next_attempt", "created_at").
+		Values(jobId, schema.ArchivingStatePending, 0, now.Unix(), now.Unix()).
+		RunWith(r.stmtCache).Exec()
+	return err
+}
+
+// Claim marks the pending entry that is due the longest as running and returns it.
+// The returned entry includes the new attempt. If no entry is due, nil is returned.
+func (r *ArchivingRepository) Claim(now time.Time) (*schema.ArchivingTask, error) {
+	for {
+		q := sq.Select(archivingColumns...).From("archive_queue").
+			Where("archive_queue.state = ?", schema.ArchivingStatePending).
+			Where("archive_queue.next_attempt <= ?", now.Unix()).
+			OrderBy("archive_queue.next_attempt ASC", "archive_queue.id ASC").
+			Limit(1)
+		task, err := scanArchivingTask(q.RunWith(r.stmtCache).QueryRow())
+		if err == sql.ErrNoRows {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		// Only one of several concurrent claims of the same entry updates a row:
+		res, err := sq.Update("archive_queue").
+			Set("state", schema.ArchivingStateRunning).
+			Set("attempts", task.Attempts+1).
+			Where("archive_queue.id = ?", task.ID).
+			Where("archive_queue.state = ?", schema.ArchivingStatePending).
+			RunWith(r.stmtCache).Exec()
+		if err != nil {
+			return nil, err
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return nil, err
+		} else if n == 1 {
+			task.State = schema.ArchivingStateRunning
+			task.Attempts += 1
+			return task, nil
+		}
+	}
+}
+
+// NextAttempt returns the time of the earliest pending entry, the zero time if there is none.
+func (r *ArchivingRepository) NextAttempt() (time.Time, error) {
+	var next sql.NullInt64
+	if err := sq.Select("MIN(archive_queue.next_attempt)").From("archive_queue").
+		Where("archive_queue.state = ?", schema.ArchivingStatePending).
+		RunWith(r.stmtCache).QueryRow().Scan(&next); err != nil {
+		return time.Time{}, err
+	}
+
+	if !next.Valid {
+		return time.Time{}, nil
+	}
+	return time.Unix(next.Int64, 0), nil
+}
+
+// Done removes the entry of a successfully archived job.
+func (r *ArchivingRepository) Done(id int64) error {
+	_, err := sq.Delete("archive_queue").Where("archive_queue.id = ?", id).RunWith(r.stmtCache).Exec()
+	return err
+}
+
+// DoneJob removes the entry of a job if there is one.
+func (r *ArchivingRepository) DoneJob(jobId int64) error {
+	_, err := sq.Delete("archive_queue").Where("archive_queue.job_id = ?", jobId).RunWith(r.stmtCache).Exec()
+	return err
+}
+
+// Failed records a failed attempt. If `next` is nil, no further attempt is made.
+func (r *ArchivingRepository) Failed(id int64, cause error, next *time.Time) error {
+	q := sq.Update("archive_queue").
+		Set("last_error", cause.Error()).
+		Where("archive_queue.id = ?", id)
+	if next != nil {
+		q = q.Set("state", schema.ArchivingStatePending).Set("next_attempt", next.Unix())
+	} else {
+		q = q.Set("state", schema.ArchivingStateFailed)
+	}
+
+	_, err := q.RunWith(r.stmtCache).Exec()
+	return err
+}
+
+// Retry resets an entry that is not running to pending with no attempts so far.
+// To check if no such entry exists test err == sql.ErrNoRows
+func (r *ArchivingRepository) Retry(id int64, now time.Time) (*schema.ArchivingTask, error) {
+	return r.setState(id, sq.Update("archive_queue").
+		Set("state", schema.ArchivingStatePending).
+		Set("attempts", 0).
+		Set("next_attempt", now.Unix()))
+}
+
+// Abandon gives up an entry that is not running.
+// To check if no such entry exists test err == sql.ErrNoRows
+func (r *ArchivingRepository) Abandon(id int64) (*schema.ArchivingTask, error) {
+	return r.setState(id, sq.Update("archive_queue").Set("state", schema.ArchivingStateAbandoned))
+}
+
+func (r *ArchivingRepository) setState(id int64, q sq.UpdateBuilder) (*schema.ArchivingTask, error) {
+	res, err := q.Where("archive_queue.id = ?", id).
+		Where("archive_queue.state != ?", schema.ArchivingStateRunning).
+		RunWith(r.stmtCache).Exec()
+	if err != nil {
+		return nil, err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return nil, err
+	} else if n == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return r.FindTask(id)
+}
+
+// ResetRunning marks all running entries as pending again. Attempts that were interrupted by
+// a shutdown or crash are thereby resumed. It returns the number of entries reset.
+func (r *ArchivingRepository) ResetRunning(now time.Time) (int64, error) {
+	res, err := sq.Update("archive_queue").
+		Set("state", schema.ArchivingStatePending).
+		Set("next_attempt", now.Unix()).
+		Where("archive_queue.state = ?", schema.ArchivingStateRunning).
+		RunWith(r.stmtCache).Exec()
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// Clear removes all entries.
+func (r *ArchivingRepository) Clear() error {
+	_, err := sq.Delete("archive_queue").RunWith(r.stmtCache).Exec()
+	return err
+}
@@ -0,0 +1,551 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/jmoiron/sqlx"
+	"github.com/mattn/go-sqlite3"
+)
+
+// All tables that are part of a backup, in an order that
+// satisfies the foreign key constraints when inserting.
+var backupTables = []string{"user", "configuration", "tag", "job", "jobtag", "archive_queue", "node", "node_state"}
+
+// The first bytes of every SQLite database file.
+const sqliteHeader string = "SQLite format 3\x00"
+
+// The SQLite online backup copies this many pages at once and pauses in between,
+// so that the database is not locked for the whole backup.
+const (
+	backupPagesPerStep int           = 1000
+	backupStepPause    time.Duration = 10 * time.Millisecond
+)
+
+// Header of the portable backup format used for MySQL databases. The header is the
+// first line of the file, followed by one line per table (`BackupTable`), each followed
+// by one JSON array per row.
+type BackupHeader struct {
+	Driver    string              `json:"driver"`
+	CreatedAt int64               `json:"createdAt"`
+	Tables    []string            `json:"tables"`  // In the order in which they follow
+	Columns   map[string][]string `json:"columns"` // The columns of every table
+}
+
+type BackupTable struct {
+	Table   string   `json:"table"`
+	Columns []string `json:"columns"`
+	Rows    int      `json:"rows"`
+}
+
+// BackupDB writes a consistent copy of the database to `file` while the database can still be used.
+// For SQLite, the online backup API is used and the result is a SQLite database file.
+// For MySQL, all tables are exported within a single read-only transaction.
+func BackupDB(file string) error {
+	db := GetConnection()
+	if _, err := os.Stat(file); err == nil {
+		return fmt.Errorf("backup file %#v already exists", file)
+	}
+
+	if db.Driver == "sqlite3" {
+		return backupSqlite(db.DB, file)
+	}
+
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+
+	if err := exportDB(db.DB, db.Driver, f); err != nil {
+		f.Close()
+		os.Remove(file)
+		return err
+	}
+
+	return f.Close()
+}
+
+// backupSqlite copies the database using the SQLite online backup API. The file is removed if anything fails.
+func backupSqlite(db *sqlx.DB, file string) error {
+	if err := copySqlite(db, file); err != nil {
+		os.Remove(file)
+		return err
+	}
+	return nil
+}
+
+func copySqlite(db *sqlx.DB, file string) error {
+	dest, err := sql.Open("sqlite3", file)
+	if err != nil {
+		return err
+	}
+	defer dest.Close()
+
+	ctx := context.Background()
+	destConn, err := dest.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer destConn.Close()
+
+	srcConn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer srcConn.Close()
+
+	return destConn.Raw(func(destDriverConn interface{}) error {
+		return srcConn.Raw(func(srcDriverConn interface{}) error {
+			destSqlite, ok1 := destDriverConn.(*sqlite3.SQLiteConn)
+			srcSqlite, ok2 := srcDriverConn.(*sqlite3.SQLiteConn)
+			if !ok1 || !ok2 {
+				return errors.New("unexpected database connection type")
+			}
+
+			backup, err := destSqlite.Backup("main", srcSqlite, "main")
+			if err != nil {
+				return err
+			}
+
+			for {
+				done, err := backup.Step(backupPagesPerStep)
+				if err != nil {
+					backup.Finish()
+					return err
+				}
+				if done {
+					break
+				}
+				time.Sleep(backupStepPause)
+			}
+			return backup.Finish()
+		})
+	})
+}
+
+func existingTables(q sqlx.Queryer, driver string) (map[string]bool, error) {
+	query := "SELECT name FROM sqlite_master WHERE type = 'table'"
+	if driver == "mysql" {
+		query = "SELECT table_name FROM information_schema.tables WHERE table_schema = DATABASE()"
+	}
+
+	rows, err := q.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tables := map[string]bool{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		tables[name] = true
+	}
+	return tables, rows.Err()
+}
+
+// tableColumns returns the names of the columns of a table.
+func tableColumns(q sqlx.Queryer, table string) ([]string, error) {
+	rows, err := q.Query(fmt.Sprintf("SELECT * FROM `%s` LIMIT 0", table))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return rows.Columns()
+}
+
+// sameColumns returns true if both tables have the same columns, in any order.
+func sameColumns(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	columns := make(map[string]bool, len(a))
+	for _, c := range a {
+		columns[c] = true
+	}
+	for _, c := range b {
+		if !columns[c] {
+			return false
+		}
+	}
+	return true
+}
+
+// Export all tables in the portable format described at `BackupHeader`.
+func exportDB(db *sqlx.DB, driver string, w io.Writer) error {
+	// In a repeatable read transaction, all reads see the same snapshot of the database.
+	tx, err := db.BeginTxx(context.Background(), &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	tables, err := existingTables(tx, driver)
+	if err != nil {
+		return err
+	}
+
+	header := BackupHeader{Driver: driver, CreatedAt: time.Now().Unix(), Columns: map[string][]string{}}
+	for _, table := range backupTables {
+		if tables[table] {
+			columns, err := tableColumns(tx, table)
+			if err != nil {
+				return err
+			}
+			header.Tables = append(header.Tables, table)
+			header.Columns[table] = columns
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	if err := enc.Encode(header); err != nil {
+		return err
+	}
+
+	for _, table := range header.Tables {
+		var count int
+		if err := tx.QueryRow(fmt.Sprintf("SELECT COUNT(*) FROM `%s`", table)).Scan(&count); err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(fmt.Sprintf("SELECT * FROM `%s`", table))
+		if err != nil {
+			return err
+		}
+
+		columns, err := rows.Columns()
+		if err != nil {
+			rows.Close()
+			return err
+		}
+
+		if err := enc.Encode(BackupTable{Table: table, Columns: columns, Rows: count}); err != nil {
+			rows.Close()
+			return err
+		}
+
+		values := make([]interface{}, len(columns))
+		dest := make([]interface{}, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+
+		n := 0
+		for rows.Next() {
+			if err := rows.Scan(dest...); err != nil {
+				rows.Close()
+				return err
+			}
+
+			for i, v := range values {
+				if b, ok := v.([]byte); ok {
+					values[i] = string(b)
+				}
+			}
+
+			if err := enc.Encode(values); err != nil {
+				rows.Close()
+				return err
+			}
+			n += 1
+		}
+		rows.Close()
+
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		if n != count {
+			return fmt.Errorf("table %#v changed during the backup", table)
+		}
+		log.Infof("backup: exported %d rows from table %#v", n, table)
+	}
+
+	return bw.Flush()
+}
+
+// RestoreDB replaces the contents of all tables with the ones from a backup created by BackupDB.
+// Both backup formats can be restored into both SQLite and MySQL databases. The backup is
+// rejected if a table has other columns than in the database (tables missing in the backup
+// or in the database are skipped). All changes happen in a single transaction, so the
+// database is left untouched if anything fails.
+func RestoreDB(file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	header := make([]byte, len(sqliteHeader))
+	if _, err := io.ReadFull(f, header); err != nil {
+		return fmt.Errorf("reading backup file failed: %w", err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	// Make sure all tables that are created lazily exist.
+	GetNodeRepository()
+	GetUserCfgRepo()
+	GetArchivingRepository()
+
+	db := GetConnection()
+	tx, err := db.DB.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	tables, err := existingTables(tx, db.Driver)
+	if err != nil {
+		return err
+	}
+
+	var source backupSource
+	if string(header) == sqliteHeader {
+		source, err = openSqliteBackup(file)
+	} else {
+		source, err = openPortableBackup(f)
+	}
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	var errs []string
+	for _, table := range backupTables {
+		if !tables[table] || !source.Has(table) {
+			continue
+		}
+
+		columns, err := tableColumns(tx, table)
+		if err != nil {
+			return err
+		}
+		if !sameColumns(columns, source.Columns(table)) {
+			errs = append(errs, fmt.Sprintf("table %#v has the columns %v, expected %v", table, source.Columns(table), columns))
+		}
+	}
+	if len(errs) != 0 {
+		return fmt.Errorf("backup does not match the database schema: %s", strings.Join(errs, ", "))
+	}
+
+	// Delete in reverse order because of the foreign keys. The entries of the archiving queue
+	// refer to the jobs that are replaced, so it is cleared even if the backup predates it.
+	for i := len(backupTables) - 1; i >= 0; i-- {
+		table := backupTables[i]
+		if !tables[table] || (!source.Has(table) && table != "archive_queue") {
+			continue
+		}
+
+		if _, err := tx.Exec(fmt.Sprintf("DELETE FROM `%s`", table)); err != nil {
+			return err
+		}
+	}
+
+	for _, table := range backupTables {
+		if !source.Has(table) {
+			continue
+		}
+		if !tables[table] {
+			log.Warnf("restore: table %#v does not exist, skipping it", table)
+			if _, err := source.Copy(table, func(columns []string, values []interface{}) error { return nil }); err != nil {
+				return err
+			}
+			continue
+		}
+
+		n, err := source.Copy(table, func(columns []string, values []interface{}) error {
+			quoted := make([]string, len(columns))
+			for i, c := range columns {
+				quoted[i] = "`" + c + "`"
+			}
+
+			_, err := tx.Exec(fmt.Sprintf("INSERT INTO `%s` (%s) VALUES (?%s)",
+				table, strings.Join(quoted, ", "), strings.Repeat(", ?", len(columns)-1)), values...)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("restoring table %#v failed: %w", table, err)
+		}
+		log.Infof("restore: imported %d rows into table %#v", n, table)
+	}
+
+	return tx.Commit()
+}
+
+// A backupSource provides the rows of the tables of a backup.
+type backupSource interface {
+	Has(table string) bool
+	Columns(table string) []string
+	Copy(table string, insert func(columns []string, values []interface{}) error) (int, error)
+	Close() error
+}
+
+type sqliteBackup struct {
+	db      *sqlx.DB
+	tables  map[string]bool
+	columns map[string][]string
+}
+
+func openSqliteBackup(file string) (*sqliteBackup, error) {
+	db, err := sqlx.Open("sqlite3", fmt.Sprintf("file:%s?mode=ro", file))
+	if err != nil {
+		return nil, err
+	}
+
+	tables, err := existingTables(db, "sqlite3")
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	columns := make(map[string][]string, len(tables))
+	for table := range tables {
+		if columns[table], err = tableColumns(db, table); err != nil {
+			db.Close()
+			return nil, err
+		}
+	}
+
+	return &sqliteBackup{db: db, tables: tables, columns: columns}, nil
+}
+
+func (b *sqliteBackup) Has(table string) bool {
+	return b.tables[table]
+}
+
+func (b *sqliteBackup) Columns(table string) []string {
+	return b.columns[table]
+}
+
+func (b *sqliteBackup) Copy(table string, insert func(columns []string, values []interface{}) error) (int, error) {
+	rows, err := b.db.Query(fmt.Sprintf("SELECT * FROM `%s`", table))
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+
+	values := make([]interface{}, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+
+	n := 0
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return n, err
+		}
+		if err := insert(columns, values); err != nil {
+			return n, err
+		}
+		n += 1
+	}
+	return n, rows.Err()
+}
+
+func (b *sqliteBackup) Close() error {
+	return b.db.Close()
+}
+
+// The portable format is read sequentially, so `Copy` has to
+// be called in the order in which the tables appear in the backup.
+type portableBackup struct {
+	dec    *json.Decoder
+	header BackupHeader
+}
+
+func openPortableBackup(r io.Reader) (*portableBackup, error) {
+	dec := json.NewDecoder(bufio.NewReader(r))
+	dec.UseNumber()
+
+	b := &portableBackup{dec: dec}
+	if err := dec.Decode(&b.header); err != nil {
+		return nil, fmt.Errorf("not a valid backup file: %w", err)
+	}
+
+	return b, nil
+}
+
+func jsonNumberValue(n json.Number) interface{} {
+	if x, err := n.Int64(); err == nil {
+		return x
+	}
+	if x, err := n.Float64(); err == nil {
+		return x
+	}
+	return n.String()
+}
+
+func (b *portableBackup) Has(table string) bool {
+	for _, t := range b.header.Tables {
+		if t == table {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *portableBackup) Columns(table string) []string {
+	return b.header.Columns[table]
+}
+
+func (b *portableBackup) Copy(table string, insert func(columns []string, values []interface{}) error) (int, error) {
+	t := BackupTable{}
+	if err := b.dec.Decode(&t); err != nil {
+		return 0, fmt.Errorf("not a valid backup file: %w", err)
+	}
+	if t.Table != table {
+		return 0, fmt.Errorf("not a valid backup file: expected table %#v, got %#v", table, t.Table)
+	}
+	if !sameColumns(t.Columns, b.Columns(table)) {
+		return 0, fmt.Errorf("not a valid backup file: the columns of table %#v differ from the header", table)
+	}
+
+	for i := 0; i < t.Rows; i++ {
+		var row []interface{}
+		if err := b.dec.Decode(&row); err != nil {
+			return i, fmt.Errorf("not a valid backup file: %w", err)
+		}
+		if len(row) != len(t.Columns) {
+			return i, fmt.Errorf("not a valid backup file: wrong number of columns in table %#v", table)
+		}
+
+		for j, v := range row {
+			if n, ok := v.(json.Number); ok {
+				row[j] = jsonNumberValue(n)
+			}
+		}
+
+		if err := insert(t.Columns, row); err != nil {
+			return i, err
+		}
+	}
+	return t.Rows, nil
+}
+
+func (b *portableBackup) Close() error {
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"bytes"
+	"context"
+	"testing"
+)
+
+func TestExportDB(t *testing.T) {
+	r := setup(t)
+
+	buf := &bytes.Buffer{}
+	if err := exportDB(r.DB, "sqlite3", buf); err != nil {
+		t.Fatal(err)
+	}
+
+	backup, err := openPortableBackup(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !backup.Has("job") || !backup.Has("tag") || !backup.Has("jobtag") {
+		t.Fatalf("tables missing in backup: %#v", backup.header.Tables)
+	}
+
+	columns, err := tableColumns(r.DB, "job")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sameColumns(backup.Columns("job"), columns) || sameColumns(backup.Columns("tag"), columns) {
+		t.Errorf("unexpected columns of table job: %v", backup.Columns("job"))
+	}
+
+	// Tables have to be read in order, only count the jobs.
+	counts := map[string]int{}
+	for _, table := range backup.header.Tables {
+		n, err := backup.Copy(table, func(columns []string, values []interface{}) error {
+			if table == "job" && (len(columns) != len(values) || columns[0] != "id") {
+				t.Fatalf("unexpected row: %#v", values)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[table] = n
+	}
+
+	jobs, err := r.CountJobs(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if counts["job"] != jobs {
+		t.Errorf("wrong number of jobs in backup\ngot: %d \nwant: %d", counts["job"], jobs)
+	}
+}
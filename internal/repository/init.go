@@ -204,6 +204,11 @@ func InitDB() error {
 		return err
 	}
 
+	// The entries of the archiving queue refer to the jobs that were just dropped:
+	if err := GetArchivingRepository().Clear(); err != nil {
+		return err
+	}
+
 	// Inserts are bundled into transactions because in sqlite,
 	// that speeds up inserts A LOT.
 	tx, err := db.DB.Beginx()
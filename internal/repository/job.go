@@ -29,7 +29,8 @@ var (
 )
 
 type JobRepository struct {
-	DB *sqlx.DB
+	DB     *sqlx.DB
+	driver string
 
 	stmtCache *sq.StmtCache
 	cache     *lrucache.Cache
@@ -41,6 +42,7 @@ func GetJobRepository() *JobRepository {
 
 		jobRepoInstance = &JobRepository{
 			DB:        db.DB,
+			driver:    db.Driver,
 			stmtCache: sq.NewStmtCache(db.DB),
 			cache:     lrucache.New(1024 * 1024),
 		}
@@ -102,6 +104,16 @@ func (r *JobRepository) FetchMetadata(job *schema.Job) (map[string]string, error
 }
 
 func (r *JobRepository) UpdateMetadata(job *schema.Job, key, val string) (err error) {
+	return r.updateMetadata(job, func(metadata map[string]string) { metadata[key] = val })
+}
+
+// DeleteMetadata removes `key` from the metadata of a job.
+func (r *JobRepository) DeleteMetadata(job *schema.Job, key string) (err error) {
+	return r.updateMetadata(job, func(metadata map[string]string) { delete(metadata, key) })
+}
+
+// updateMetadata applies `update` to a copy of the metadata of a job and saves it.
+func (r *JobRepository) updateMetadata(job *schema.Job, update func(metadata map[string]string)) (err error) {
 	cachekey := fmt.Sprintf("metadata:%d", job.ID)
 	r.cache.Del(cachekey)
 	if job.MetaData == nil {
@@ -110,16 +122,12 @@ func (r *JobRepository) UpdateMetadata(job *schema.Job, key, val string) (err er
 		}
 	}
 
-	if job.MetaData != nil {
-		cpy := make(map[string]string, len(job.MetaData)+1)
-		for k, v := range job.MetaData {
-			cpy[k] = v
-		}
-		cpy[key] = val
-		job.MetaData = cpy
-	} else {
-		job.MetaData = map[string]string{key: val}
+	cpy := make(map[string]string, len(job.MetaData)+1)
+	for k, v := range job.MetaData {
+		cpy[k] = v
 	}
+	update(cpy)
+	job.MetaData = cpy
 
 	if job.RawMetaData, err = json.Marshal(job.MetaData); err != nil {
 		return err
@@ -202,6 +210,15 @@ func (r *JobRepository) FindById(jobId int64) (*schema.Job, error) {
 	return scanJob(q.RunWith(r.stmtCache).QueryRow())
 }
 
+// FindByIdWithUser is like FindById, but only finds jobs the user in `ctx` is allowed
+// to see (see SecurityCheck).
+func (r *JobRepository) FindByIdWithUser(ctx context.Context, jobId int64) (*schema.Job, error) {
+	q := sq.Select(jobColumns...).
+		From("job").Where("job.id = ?", jobId)
+	q = SecurityCheck(ctx, q)
+	return scanJob(q.RunWith(r.stmtCache).QueryRow())
+}
+
 // Start inserts a new job in the table, returning the unique job ID.
 // Statistics are not transfered!
 func (r *JobRepository) Start(job *schema.JobMeta) (id int64, err error) {
@@ -288,7 +305,9 @@ func (r *JobRepository) CountGroupedJobs(ctx context.Context, aggreg model.Aggre
 		}
 	}
 
-	q := sq.Select("job."+string(aggreg), count).From("job").GroupBy("job." + string(aggreg)).OrderBy("count DESC")
+	col := AggregateColumn(aggreg)
+	q := sq.Select(col, count).From("job").GroupBy(col).OrderBy("count DESC")
+	q = JoinAggregate(q, aggreg)
 	q = SecurityCheck(ctx, q)
 	for _, f := range filters {
 		q = BuildWhereClause(f, q)
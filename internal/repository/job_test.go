@@ -5,9 +5,12 @@
 package repository
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
 	_ "github.com/mattn/go-sqlite3"
 )
 
@@ -65,3 +68,166 @@ func TestGetTags(t *testing.T) {
 		t.Errorf("wrong summary for diagnostic 3\ngot: %d \nwant: 6", counts["load-imbalance"])
 	}
 }
+
+func TestQueryJobsKeyset(t *testing.T) {
+	r := setup(t)
+
+	total, err := r.CountJobs(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	order := []*model.OrderByInput{{Field: model.SortByAttributeStartTime, Order: model.SortDirectionEnumDesc}}
+	seen := map[int64]bool{}
+	pages := []*JobPage{}
+	after := new(string)
+	for {
+		page, err := r.QueryJobsKeyset(context.Background(), nil, 10, order, after, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, job := range page.Jobs {
+			if seen[job.ID] {
+				t.Fatalf("job %d returned twice", job.ID)
+			}
+			seen[job.ID] = true
+
+			if i > 0 && job.StartTime.After(page.Jobs[i-1].StartTime) {
+				t.Fatalf("jobs not sorted by start time")
+			}
+		}
+
+		if page.HasPreviousPage != (len(pages) > 0) {
+			t.Fatalf("unexpected hasPreviousPage on page %d", len(pages))
+		}
+
+		pages = append(pages, page)
+		if !page.HasNextPage {
+			break
+		}
+		after = &page.EndCursor
+	}
+
+	if len(seen) != total {
+		t.Errorf("wrong number of jobs\ngot: %d \nwant: %d", len(seen), total)
+	}
+
+	// Paginating backwards from the last page must return the second last page.
+	last, secondLast := pages[len(pages)-1], pages[len(pages)-2]
+	page, err := r.QueryJobsKeyset(context.Background(), nil, 10, order, nil, &last.StartCursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(page.Jobs) != len(secondLast.Jobs) || page.Jobs[0].ID != secondLast.Jobs[0].ID || page.EndCursor != secondLast.EndCursor {
+		t.Errorf("paginating backwards failed")
+	}
+
+	if !page.HasNextPage || page.HasPreviousPage != (len(pages) > 2) {
+		t.Errorf("unexpected page flags when paginating backwards: next %v, previous %v", page.HasNextPage, page.HasPreviousPage)
+	}
+
+	// Nothing precedes the first page, an empty cursor starts at the first job.
+	empty := ""
+	page, err = r.QueryJobsKeyset(context.Background(), nil, 10, order, nil, &pages[0].StartCursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(page.Jobs) != 0 || !page.HasNextPage || page.HasPreviousPage {
+		t.Errorf("unexpected page before the first page: %d jobs, next %v, previous %v", len(page.Jobs), page.HasNextPage, page.HasPreviousPage)
+	}
+
+	page, err = r.QueryJobsKeyset(context.Background(), nil, -1, order, &empty, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(page.Jobs) != total || page.HasNextPage || page.HasPreviousPage || page.StartCursor != pages[0].StartCursor {
+		t.Errorf("expected all jobs with a limit of -1, got %d", len(page.Jobs))
+	}
+
+	if _, err := r.QueryJobsKeyset(context.Background(), nil, 10, nil, &last.StartCursor, nil); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("expected cursor with a different sort order to be rejected, got: %v", err)
+	}
+}
+
+func TestQueryJobsOrder(t *testing.T) {
+	r := setup(t)
+
+	order := []*model.OrderByInput{
+		{Field: model.SortByAttributeUser, Order: model.SortDirectionEnumAsc},
+		{Field: model.SortByAttributeNumNodes, Order: model.SortDirectionEnumDesc},
+		{Field: model.SortByAttributeStartTime, Order: model.SortDirectionEnumAsc},
+	}
+	jobs, err := r.QueryJobs(context.Background(), nil, nil, order)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 1; i < len(jobs); i++ {
+		a, b := jobs[i-1], jobs[i]
+		if a.User > b.User ||
+			(a.User == b.User && a.NumNodes < b.NumNodes) ||
+			(a.User == b.User && a.NumNodes == b.NumNodes && a.StartTime.After(b.StartTime)) {
+			t.Fatalf("jobs %d and %d are not sorted", a.ID, b.ID)
+		}
+	}
+
+	// Jobs without the metadata key are sorted last, keyset pagination has to handle the NULL values.
+	jobName := "jobName"
+	order = []*model.OrderByInput{
+		{Field: model.SortByAttributeMetaData, MetaKey: &jobName, Order: model.SortDirectionEnumAsc},
+		{Field: model.SortByAttributeNumNodes, Order: model.SortDirectionEnumDesc},
+	}
+	seen := map[int64]bool{}
+	after := new(string)
+	for {
+		page, err := r.QueryJobsKeyset(context.Background(), nil, 7, order, after, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, job := range page.Jobs {
+			if seen[job.ID] {
+				t.Fatalf("job %d returned twice", job.ID)
+			}
+			seen[job.ID] = true
+		}
+
+		if !page.HasNextPage {
+			break
+		}
+		after = &page.EndCursor
+	}
+
+	if len(seen) != len(jobs) {
+		t.Errorf("wrong number of jobs\ngot: %d \nwant: %d", len(seen), len(jobs))
+	}
+
+	if _, err := r.QueryJobs(context.Background(), nil, nil, []*model.OrderByInput{{Field: "job_id; DROP TABLE job", Order: model.SortDirectionEnumAsc}}); err == nil {
+		t.Errorf("expected unknown sort field to be rejected")
+	}
+}
+
+func TestCountGroupedJobs(t *testing.T) {
+	r := setup(t)
+
+	counts, err := r.CountGroupedJobs(context.Background(), model.AggregateTag, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The only job with tag 1 does not exist anymore:
+	if len(counts) != 3 || counts["2"] != 15 || counts["3"] != 6 {
+		t.Errorf("unexpected counts by tag: %v", counts)
+	}
+
+	counts, err = r.CountGroupedJobs(context.Background(), model.AggregateSubcluster, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(counts) != 1 || counts["main"] == 0 {
+		t.Errorf("unexpected counts by subcluster: %v", counts)
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	sq "github.com/Masterminds/squirrel"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// A cursor marks the position of a job in a job list sorted by the keys described
+// by `Order`. `Values` contains the values of the sort keys for that job, `ID` (the
+// database id of the job) is used to break ties, so that the position is unique.
+// Clients only ever see the base64 encoded JSON representation.
+type cursor struct {
+	Order  string        `json:"o,omitempty"`
+	Values []interface{} `json:"v,omitempty"`
+	ID     int64         `json:"id"`
+}
+
+func (c *cursor) encode() string {
+	for i, v := range c.Values {
+		if b, ok := v.([]byte); ok {
+			c.Values[i] = string(b)
+		}
+	}
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		// Only happens for values that can not be represented in JSON,
+		// which the database does not return for the sortable columns.
+		log.Warnf("encoding cursor failed: %s", err.Error())
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(str string, order string, numValues int) (*cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(str)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	c := &cursor{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	if c.Order != order || len(c.Values) != numValues {
+		return nil, fmt.Errorf("%w: cursor does not match the sort order", ErrInvalidCursor)
+	}
+
+	for i, v := range c.Values {
+		if n, ok := v.(json.Number); ok {
+			if x, err := n.Int64(); err == nil {
+				c.Values[i] = x
+			} else if x, err := n.Float64(); err == nil {
+				c.Values[i] = x
+			} else {
+				return nil, ErrInvalidCursor
+			}
+		}
+	}
+
+	return c, nil
+}
+
+// JobPage is one page of a job list fetched with keyset pagination.
+type JobPage struct {
+	Jobs            []*schema.Job
+	StartCursor     string // Cursor of the first job, use it as `before` to fetch the previous page
+	EndCursor       string // Cursor of the last job, use it as `after` to fetch the next page
+	HasNextPage     bool   // True if more jobs follow after the last job
+	HasPreviousPage bool   // True if more jobs precede the first job
+}
+
+// QueryJobsKeyset returns at most `limit` jobs (all if -1) matching the filters that are sorted
+// after the job described by the opaque cursor `after` or before the job described by `before`.
+// If neither is set (or the cursor is empty), the first/last jobs are returned. In contrast to
+// QueryJobs, the cost does not grow with the position in the job list. `order` is optional, the
+// database id is always used as last sort key.
+func (r *JobRepository) QueryJobsKeyset(
+	ctx context.Context,
+	filters []*model.JobFilter,
+	limit int,
+	order []*model.OrderByInput,
+	after, before *string) (*JobPage, error) {
+
+	if after != nil && before != nil {
+		return nil, errors.New("only one of 'after' and 'before' can be used")
+	}
+	if limit <= 0 && limit != -1 {
+		return nil, errors.New("the limit must be a positive number or -1 (all jobs)")
+	}
+
+	keys, err := r.buildSortKeys(order)
+	if err != nil {
+		return nil, err
+	}
+
+	// When paginating backwards, the sort order is inverted and the result reversed afterwards.
+	backwards := before != nil
+	signature := make([]string, 0, len(keys))
+	for _, key := range keys {
+		signature = append(signature, key.signature(false))
+	}
+
+	position := after
+	if backwards {
+		position = before
+	}
+	var start *cursor
+	if position != nil && *position != "" {
+		if start, err = decodeCursor(*position, strings.Join(signature, ","), len(keys)); err != nil {
+			return nil, err
+		}
+	}
+
+	query := r.keysetQuery(ctx, sq.Select(jobColumns...), filters, keys, backwards, start, false)
+	for _, key := range keys {
+		query = query.Column(sq.Expr(key.expr, key.args...))
+	}
+	if limit != -1 {
+		query = query.Limit(uint64(limit) + 1)
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("SQL query: `%s`, args: %#v", sql, args)
+	rows, err := query.RunWith(r.stmtCache).Query()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	page := &JobPage{Jobs: make([]*schema.Job, 0)}
+	cursors := make([]*cursor, 0)
+	hasMore := false
+	for rows.Next() {
+		if len(page.Jobs) == limit {
+			hasMore = true
+			break
+		}
+
+		c := &cursor{Order: strings.Join(signature, ","), Values: make([]interface{}, len(keys))}
+		extra := make([]interface{}, len(keys))
+		for i := range c.Values {
+			extra[i] = &c.Values[i]
+		}
+
+		job, err := scanJob(withExtraColumns(rows, extra...))
+		if err != nil {
+			return nil, err
+		}
+
+		// Strings are returned as []byte, which would be compared as BLOB by sqlite.
+		for i, v := range c.Values {
+			if b, ok := v.([]byte); ok {
+				c.Values[i] = string(b)
+			}
+		}
+
+		c.ID = job.ID
+		page.Jobs = append(page.Jobs, job)
+		cursors = append(cursors, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	// Whether jobs precede the page (in the direction of the pagination) is checked by the
+	// inverted query starting at the first job of the page, or at the cursor itself (inclusive)
+	// if the page is empty. Without a cursor, the page starts at the first/last job anyway.
+	hasOther := false
+	if start != nil {
+		from, inclusive := start, true
+		if len(cursors) > 0 {
+			from, inclusive = cursors[0], false
+		}
+
+		if hasOther, err = r.keysetExists(ctx, filters, keys, !backwards, from, inclusive); err != nil {
+			return nil, err
+		}
+	}
+
+	page.HasNextPage, page.HasPreviousPage = hasMore, hasOther
+	if backwards {
+		page.HasNextPage, page.HasPreviousPage = hasOther, hasMore
+		for i, j := 0, len(page.Jobs)-1; i < j; i, j = i+1, j-1 {
+			page.Jobs[i], page.Jobs[j] = page.Jobs[j], page.Jobs[i]
+			cursors[i], cursors[j] = cursors[j], cursors[i]
+		}
+	}
+
+	if len(cursors) > 0 {
+		page.StartCursor = cursors[0].encode()
+		page.EndCursor = cursors[len(cursors)-1].encode()
+	}
+
+	return page, nil
+}
+
+// keysetQuery adds the filters, the sort order (inverted if `backwards`) and, if `position` is
+// not nil, the condition for all jobs sorted after that position to `query`.
+func (r *JobRepository) keysetQuery(
+	ctx context.Context,
+	query sq.SelectBuilder,
+	filters []*model.JobFilter,
+	keys []*sortKey,
+	backwards bool,
+	position *cursor,
+	inclusive bool) sq.SelectBuilder {
+
+	query = SecurityCheck(ctx, query.From("job"))
+	for _, f := range filters {
+		query = BuildWhereClause(f, query)
+	}
+	query = buildArrayGrouping(ctx, filters, query)
+
+	for _, key := range keys {
+		query = key.orderBy(query, backwards)
+	}
+	// The database id is sorted in the same direction as the last sort key.
+	idCmp := ">"
+	if (len(keys) > 0 && keys[len(keys)-1].desc) != backwards {
+		query = query.OrderBy("job.id DESC")
+		idCmp = "<"
+	} else {
+		query = query.OrderBy("job.id ASC")
+	}
+	if inclusive {
+		idCmp += "="
+	}
+
+	if position != nil {
+		// (k1 > v1) OR (k1 = v1 AND k2 > v2) OR ... OR (k1 = v1 AND ... AND job.id > id)
+		cond, ties := sq.Or{}, sq.And{}
+		for i, key := range keys {
+			after, tie := key.after(position.Values[i], backwards)
+			cond = append(cond, append(append(sq.And{}, ties...), after))
+			ties = append(ties, tie)
+		}
+		cond = append(cond, append(append(sq.And{}, ties...), sq.Expr("job.id "+idCmp+" ?", position.ID)))
+		query = query.Where(cond)
+	}
+
+	return query
+}
+
+// keysetExists returns true if any job is sorted after `position` (see keysetQuery).
+func (r *JobRepository) keysetExists(
+	ctx context.Context,
+	filters []*model.JobFilter,
+	keys []*sortKey,
+	backwards bool,
+	position *cursor,
+	inclusive bool) (bool, error) {
+
+	query := r.keysetQuery(ctx, sq.Select("job.id"), filters, keys, backwards, position, inclusive).Limit(1)
+	rows, err := query.RunWith(r.stmtCache).Query()
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	exists := rows.Next()
+	return exists, rows.Err()
+}
+
+type extraColumnsScanner struct {
+	rows  *sql.Rows
+	extra []interface{}
+}
+
+func (s extraColumnsScanner) Scan(dest ...interface{}) error {
+	return s.rows.Scan(append(dest, s.extra...)...)
+}
+
+// withExtraColumns allows using scanJob on rows that contain more columns than `jobColumns`.
+func withExtraColumns(rows *sql.Rows, extra ...interface{}) interface{ Scan(...interface{}) error } {
+	if len(extra) == 0 {
+		return rows
+	}
+
+	return extraColumnsScanner{rows: rows, extra: extra}
+}
@@ -0,0 +1,314 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	nodeRepoOnce     sync.Once
+	nodeRepoInstance *NodeRepository
+)
+
+// The `node` table holds the current state of every node known to
+// cc-backend, `node_state` keeps one row per state change.
+const NodesDBSchema string = `
+	CREATE TABLE IF NOT EXISTS node (
+		id         INTEGER PRIMARY KEY /*!40101 AUTO_INCREMENT */,
+		hostname   VARCHAR(255) NOT NULL,
+		cluster    VARCHAR(255) NOT NULL,
+		subcluster VARCHAR(255) NOT NULL,
+		node_state VARCHAR(255) NOT NULL CHECK(node_state IN ('idle', 'allocated', 'down', 'drain', 'maintenance')),
+		reason     TEXT,
+		time_stamp BIGINT NOT NULL, -- Unix timestamp of the last state change
+		CONSTRAINT node_unique UNIQUE (hostname, cluster));
+
+	CREATE TABLE IF NOT EXISTS node_state (
+		id         INTEGER PRIMARY KEY /*!40101 AUTO_INCREMENT */,
+		node_id    INTEGER NOT NULL,
+		node_state VARCHAR(255) NOT NULL CHECK(node_state IN ('idle', 'allocated', 'down', 'drain', 'maintenance')),
+		reason     TEXT,
+		time_stamp BIGINT NOT NULL, -- Unix timestamp
+		FOREIGN KEY (node_id) REFERENCES node (id) ON DELETE CASCADE);
+`
+
+type NodeRepository struct {
+	DB *sqlx.DB
+
+	stmtCache *sq.StmtCache
+}
+
+func GetNodeRepository() *NodeRepository {
+	nodeRepoOnce.Do(func() {
+		db := GetConnection()
+
+		if _, err := db.DB.Exec(NodesDBSchema); err != nil {
+			log.Fatal(err)
+		}
+
+		nodeRepoInstance = &NodeRepository{
+			DB:        db.DB,
+			stmtCache: sq.NewStmtCache(db.DB),
+		}
+	})
+
+	return nodeRepoInstance
+}
+
+var nodeColumns []string = []string{
+	"node.id", "node.hostname", "node.cluster", "node.subcluster", "node.node_state", "node.reason", "node.time_stamp",
+}
+
+func scanNode(row interface{ Scan(...interface{}) error }) (*schema.Node, error) {
+	node := &schema.Node{}
+	var reason sql.NullString
+	if err := row.Scan(
+		&node.ID, &node.Hostname, &node.Cluster, &node.SubCluster, &node.NodeState, &reason, &node.TimeStampUnix); err != nil {
+		return nil, err
+	}
+
+	node.Reason = reason.String
+	node.TimeStamp = time.Unix(node.TimeStampUnix, 0)
+	return node, nil
+}
+
+// FindNode returns the node with the given hostname in cluster.
+// To check if no node was found test err == sql.ErrNoRows
+func (r *NodeRepository) FindNode(cluster, hostname string) (*schema.Node, error) {
+	return scanNode(selectNode(cluster, hostname).RunWith(r.stmtCache).QueryRow())
+}
+
+func selectNode(cluster, hostname string) sq.SelectBuilder {
+	return sq.Select(nodeColumns...).From("node").
+		Where("node.cluster = ?", cluster).
+		Where("node.hostname = ?", hostname)
+}
+
+// A NodeStateUpdate is the reported state of one node.
+type NodeStateUpdate struct {
+	Hostname string
+	State    schema.NodeState
+	Reason   string
+}
+
+// UpdateNodeState records the state of a node as reported at time `ts`.
+// Unknown nodes are added to the inventory. A new history entry is only
+// written if the state or the reason actually changed. Reports older than
+// the current state of the node only add a history entry.
+func (r *NodeRepository) UpdateNodeState(
+	cluster, hostname string,
+	state schema.NodeState,
+	reason string,
+	ts time.Time) (*schema.Node, error) {
+
+	nodes, err := r.UpdateNodeStates(cluster, []NodeStateUpdate{{Hostname: hostname, State: state, Reason: reason}}, ts)
+	if err != nil {
+		return nil, err
+	}
+	return nodes[0], nil
+}
+
+// UpdateNodeStates is like UpdateNodeState for several nodes of a cluster. All
+// states are recorded in a single transaction: If one update fails, none is applied.
+func (r *NodeRepository) UpdateNodeStates(cluster string, updates []NodeStateUpdate, ts time.Time) ([]*schema.Node, error) {
+	for _, u := range updates {
+		if !u.State.Valid() {
+			return nil, fmt.Errorf("not a valid node state: %#v", u.State)
+		}
+	}
+	if archive.GetCluster(cluster) == nil {
+		return nil, fmt.Errorf("no such cluster: %#v", cluster)
+	}
+
+	// The node and its history entry are written together, concurrent updates
+	// of the same node must not leave a state without history entry behind.
+	tx, err := r.DB.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	nodes := make([]*schema.Node, 0, len(updates))
+	for _, u := range updates {
+		node, err := updateNodeState(tx, cluster, u, ts)
+		if err != nil {
+			return nil, fmt.Errorf("node %#v: %w", u.Hostname, err)
+		}
+		nodes = append(nodes, node)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+func updateNodeState(tx *sqlx.Tx, cluster string, u NodeStateUpdate, ts time.Time) (*schema.Node, error) {
+	node, err := scanNode(selectNode(cluster, u.Hostname).RunWith(tx).QueryRow())
+	if err == sql.ErrNoRows {
+		subcluster, err := archive.GetSubClusterByNode(cluster, u.Hostname)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := sq.Insert("node").
+			Columns("hostname", "cluster", "subcluster", "node_state", "reason", "time_stamp").
+			Values(u.Hostname, cluster, subcluster, u.State, u.Reason, ts.Unix()).
+			RunWith(tx).Exec()
+		if err != nil {
+			return nil, err
+		}
+
+		node = &schema.Node{Hostname: u.Hostname, Cluster: cluster, SubCluster: subcluster}
+		if node.ID, err = res.LastInsertId(); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	} else if ts.Unix() < node.TimeStampUnix {
+		// A late report does not replace a newer state, it only belongs in the history.
+		return node, insertNodeState(tx, node.ID, u, ts)
+	} else if node.NodeState == u.State && node.Reason == u.Reason {
+		return node, nil
+	} else {
+		if _, err := sq.Update("node").
+			Set("node_state", u.State).
+			Set("reason", u.Reason).
+			Set("time_stamp", ts.Unix()).
+			Where("node.id = ?", node.ID).
+			RunWith(tx).Exec(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := insertNodeState(tx, node.ID, u, ts); err != nil {
+		return nil, err
+	}
+
+	node.NodeState, node.Reason = u.State, u.Reason
+	node.TimeStampUnix, node.TimeStamp = ts.Unix(), time.Unix(ts.Unix(), 0)
+	return node, nil
+}
+
+func insertNodeState(tx *sqlx.Tx, id int64, u NodeStateUpdate, ts time.Time) error {
+	_, err := sq.Insert("node_state").
+		Columns("node_id", "node_state", "reason", "time_stamp").
+		Values(id, u.State, u.Reason, ts.Unix()).
+		RunWith(tx).Exec()
+	return err
+}
+
+// QueryNodes returns all nodes matching the provided filters.
+func (r *NodeRepository) QueryNodes(filters []*model.NodeFilter) ([]*schema.Node, error) {
+	query := sq.Select(nodeColumns...).From("node").OrderBy("node.cluster ASC", "node.hostname ASC")
+	for _, f := range filters {
+		query = buildNodeWhereClause(f, query)
+	}
+
+	rows, err := query.RunWith(r.stmtCache).Query()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	nodes := make([]*schema.Node, 0, 50)
+	for rows.Next() {
+		node, err := scanNode(rows)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+
+	return nodes, rows.Err()
+}
+
+// CountNodeStates returns the number of nodes per state for all nodes matching the filters.
+func (r *NodeRepository) CountNodeStates(filters []*model.NodeFilter) (map[string]int, error) {
+	query := sq.Select("node.node_state", "count(*)").From("node").GroupBy("node.node_state")
+	for _, f := range filters {
+		query = buildNodeWhereClause(f, query)
+	}
+
+	rows, err := query.RunWith(r.stmtCache).Query()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := map[string]int{}
+	for rows.Next() {
+		var state string
+		var count int
+		if err := rows.Scan(&state, &count); err != nil {
+			return nil, err
+		}
+		counts[state] = count
+	}
+
+	return counts, rows.Err()
+}
+
+// NodeStateHistory returns all state changes of the node with the database id `node`
+// in chronological order, optionally limited to the time range [from, to].
+func (r *NodeRepository) NodeStateHistory(node int64, from, to *time.Time) ([]*schema.NodeStateChange, error) {
+	query := sq.Select("node_state.node_state", "node_state.reason", "node_state.time_stamp").
+		From("node_state").
+		Where("node_state.node_id = ?", node).
+		OrderBy("node_state.time_stamp ASC", "node_state.id ASC")
+	query = buildTimeCondition("node_state.time_stamp", &schema.TimeRange{From: from, To: to}, query)
+
+	rows, err := query.RunWith(r.stmtCache).Query()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := make([]*schema.NodeStateChange, 0)
+	for rows.Next() {
+		change := &schema.NodeStateChange{}
+		var reason sql.NullString
+		if err := rows.Scan(&change.NodeState, &reason, &change.TimeStampUnix); err != nil {
+			return nil, err
+		}
+		change.Reason = reason.String
+		change.TimeStamp = time.Unix(change.TimeStampUnix, 0)
+		history = append(history, change)
+	}
+
+	return history, rows.Err()
+}
+
+func buildNodeWhereClause(filter *model.NodeFilter, query sq.SelectBuilder) sq.SelectBuilder {
+	if filter.Hostname != nil {
+		query = buildStringCondition("node.hostname", filter.Hostname, query)
+	}
+	if filter.Cluster != nil {
+		query = buildStringCondition("node.cluster", filter.Cluster, query)
+	}
+	if filter.SubCluster != nil {
+		query = buildStringCondition("node.subcluster", filter.SubCluster, query)
+	}
+	if filter.NodeState != nil {
+		states := make([]string, len(filter.NodeState))
+		for i, val := range filter.NodeState {
+			states[i] = string(val)
+		}
+
+		query = query.Where(sq.Eq{"node.node_state": states})
+	}
+	return query
+}
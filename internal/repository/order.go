@@ -0,0 +1,167 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
+	sq "github.com/Masterminds/squirrel"
+)
+
+// Only these columns can be used for sorting jobs.
+// GraphQL validation should make sure that no unkown values can be specified,
+// but other callers of QueryJobs could pass anything.
+var sortableColumns = map[model.SortByAttribute]string{
+	model.SortByAttributeID:               "job.id",
+	model.SortByAttributeJobID:            "job.job_id",
+	model.SortByAttributeUser:             "job.user",
+	model.SortByAttributeProject:          "job.project",
+	model.SortByAttributeCluster:          "job.cluster",
+	model.SortByAttributeSubCluster:       "job.subcluster",
+	model.SortByAttributePartition:        "job.partition",
+	model.SortByAttributeArrayJobID:       "job.array_job_id",
+	model.SortByAttributeStartTime:        "job.start_time",
+	model.SortByAttributeDuration:         "job.duration",
+	model.SortByAttributeWalltime:         "job.walltime",
+	model.SortByAttributeNumNodes:         "job.num_nodes",
+	model.SortByAttributeNumHWThreads:     "job.num_hwthreads",
+	model.SortByAttributeNumAcc:           "job.num_acc",
+	model.SortByAttributeExclusive:        "job.exclusive",
+	model.SortByAttributeMonitoringStatus: "job.monitoring_status",
+	model.SortByAttributeSmt:              "job.smt",
+	model.SortByAttributeState:            "job.job_state",
+	model.SortByAttributeFlopsAnyAvg:      "job.flops_any_avg",
+	model.SortByAttributeMemBwAvg:         "job.mem_bw_avg",
+	model.SortByAttributeLoadAvg:          "job.load_avg",
+	model.SortByAttributeMemUsedMax:       "job.mem_used_max",
+	model.SortByAttributeNetBwAvg:         "job.net_bw_avg",
+	model.SortByAttributeNetDataVolTotal:  "job.net_data_vol_total",
+	model.SortByAttributeFileBwAvg:        "job.file_bw_avg",
+	model.SortByAttributeFileDataVolTotal: "job.file_data_vol_total",
+}
+
+// A sortKey is one validated element of an ORDER BY clause.
+type sortKey struct {
+	name       string // Identifies the key in cursors, e.g. "startTime" or "metaData.jobName"
+	expr       string // SQL expression, may contain placeholders for `args`
+	args       []interface{}
+	desc       bool
+	nullsFirst bool
+	nullable   bool // Only metadata values can be NULL, all other sortable columns are NOT NULL
+}
+
+// buildSortKeys validates the requested order and translates it to SQL expressions.
+func (r *JobRepository) buildSortKeys(order []*model.OrderByInput) ([]*sortKey, error) {
+	keys := make([]*sortKey, 0, len(order))
+	for _, o := range order {
+		if o.Order != model.SortDirectionEnumAsc && o.Order != model.SortDirectionEnumDesc {
+			return nil, errors.New("invalid sorting order")
+		}
+
+		key := &sortKey{
+			name:       string(o.Field),
+			desc:       o.Order == model.SortDirectionEnumDesc,
+			nullsFirst: o.Nulls != nil && *o.Nulls == model.NullsOrderFirst,
+		}
+
+		if o.Field == model.SortByAttributeMetaData {
+			if o.MetaKey == nil || *o.MetaKey == "" {
+				return nil, errors.New("sorting by metaData requires a metaKey")
+			}
+			if strings.ContainsAny(*o.MetaKey, "\"\\") {
+				return nil, fmt.Errorf("invalid metaKey: %#v", *o.MetaKey)
+			}
+
+			key.name += "." + *o.MetaKey
+			key.expr, key.args = r.metaDataExpr(*o.MetaKey)
+			key.nullable = true
+		} else if col, ok := sortableColumns[o.Field]; ok {
+			key.expr = col
+		} else {
+			return nil, fmt.Errorf("invalid sorting field: %#v", o.Field)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// The metadata column contains a JSON object, the value is NULL if the key is not set.
+func (r *JobRepository) metaDataExpr(key string) (string, []interface{}) {
+	path := fmt.Sprintf("$.\"%s\"", key)
+	if r.driver == "mysql" {
+		return "(CASE WHEN JSON_VALID(job.meta_data) THEN JSON_UNQUOTE(JSON_EXTRACT(job.meta_data, ?)) END)", []interface{}{path}
+	}
+
+	return "(CASE WHEN json_valid(job.meta_data) THEN json_extract(job.meta_data, ?) END)", []interface{}{path}
+}
+
+// signature describes the sort keys in a way that is used to verify that a cursor
+// was created for the same sort order.
+func (k *sortKey) signature(inverted bool) string {
+	dir := "ASC"
+	if k.desc != inverted {
+		dir = "DESC"
+	}
+
+	if !k.nullable {
+		return k.name + " " + dir
+	}
+
+	nulls := "LAST"
+	if k.nullsFirst != inverted {
+		nulls = "FIRST"
+	}
+	return k.name + " " + dir + " NULLS " + nulls
+}
+
+// orderBy adds the key to the query. If `inverted` is true, the sort order is reversed,
+// including the position of NULL values.
+func (k *sortKey) orderBy(query sq.SelectBuilder, inverted bool) sq.SelectBuilder {
+	dir := "ASC"
+	if k.desc != inverted {
+		dir = "DESC"
+	}
+
+	if k.nullable {
+		// `NULLS FIRST/LAST` is not supported by MySQL.
+		if k.nullsFirst != inverted {
+			query = query.OrderByClause(sq.Expr(k.expr+" IS NULL DESC", k.args...))
+		} else {
+			query = query.OrderByClause(sq.Expr(k.expr+" IS NULL ASC", k.args...))
+		}
+	}
+
+	return query.OrderByClause(sq.Expr(k.expr+" "+dir, k.args...))
+}
+
+// after returns a condition that matches all rows that are sorted after `value`
+// (ties excluded) and one that matches the ties.
+func (k *sortKey) after(value interface{}, inverted bool) (after, tie sq.Sqlizer) {
+	cmp := ">"
+	if k.desc != inverted {
+		cmp = "<"
+	}
+	nullsFirst := k.nullsFirst != inverted
+
+	if value == nil {
+		tie = sq.Expr(k.expr+" IS NULL", k.args...)
+		if nullsFirst {
+			return sq.Expr(k.expr+" IS NOT NULL", k.args...), tie
+		}
+		return sq.Expr("1 = 0"), tie
+	}
+
+	args := append(append(make([]interface{}, 0, len(k.args)+1), k.args...), value)
+	after, tie = sq.Expr(k.expr+" "+cmp+" ?", args...), sq.Expr(k.expr+" = ?", args...)
+	if k.nullable && !nullsFirst {
+		after = sq.Or{after, sq.Expr(k.expr+" IS NULL", k.args...)}
+	}
+	return after, tie
+}
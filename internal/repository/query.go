@@ -6,10 +6,7 @@ package repository
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"regexp"
-	"strings"
 	"time"
 
 	"github.com/ClusterCockpit/cc-backend/internal/auth"
@@ -20,24 +17,22 @@ import (
 )
 
 // QueryJobs returns a list of jobs matching the provided filters. page and order are optional-
+// The elements of order are applied in the given order, later ones are only used for ties.
 func (r *JobRepository) QueryJobs(
 	ctx context.Context,
 	filters []*model.JobFilter,
 	page *model.PageRequest,
-	order *model.OrderByInput) ([]*schema.Job, error) {
+	order []*model.OrderByInput) ([]*schema.Job, error) {
 
 	query := sq.Select(jobColumns...).From("job")
 	query = SecurityCheck(ctx, query)
 
-	if order != nil {
-		field := toSnakeCase(order.Field)
-		if order.Order == model.SortDirectionEnumAsc {
-			query = query.OrderBy(fmt.Sprintf("job.%s ASC", field))
-		} else if order.Order == model.SortDirectionEnumDesc {
-			query = query.OrderBy(fmt.Sprintf("job.%s DESC", field))
-		} else {
-			return nil, errors.New("invalid sorting order")
-		}
+	keys, err := r.buildSortKeys(order)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		query = key.orderBy(query, false)
 	}
 
 	if page != nil && page.ItemsPerPage != -1 {
@@ -48,6 +43,7 @@ func (r *JobRepository) QueryJobs(
 	for _, f := range filters {
 		query = BuildWhereClause(f, query)
 	}
+	query = buildArrayGrouping(ctx, filters, query)
 
 	sql, args, err := query.ToSql()
 	if err != nil {
@@ -84,6 +80,8 @@ func (r *JobRepository) CountJobs(
 	for _, f := range filters {
 		query = BuildWhereClause(f, query)
 	}
+	query = buildArrayGrouping(ctx, filters, query)
+
 	var count int
 	if err := query.RunWith(r.DB).Scan(&count); err != nil {
 		return 0, err
@@ -101,6 +99,32 @@ func SecurityCheck(ctx context.Context, query sq.SelectBuilder) sq.SelectBuilder
 	return query.Where("job.user = ?", user.Username)
 }
 
+// GraphQL validation should make sure that no unkown values can be specified.
+var aggregate2column = map[model.Aggregate]string{
+	model.AggregateUser:       "job.user",
+	model.AggregateProject:    "job.project",
+	model.AggregateCluster:    "job.cluster",
+	model.AggregateSubcluster: "job.subcluster",
+	model.AggregatePartition:  "job.partition",
+	model.AggregateTag:        "grouptag.tag_id",
+}
+
+// AggregateColumn returns the column to group jobs by. The query has to be passed to
+// JoinAggregate as some columns are not in the job table.
+func AggregateColumn(aggreg model.Aggregate) string {
+	return aggregate2column[aggreg]
+}
+
+// JoinAggregate joins the tables needed for the column returned by AggregateColumn. A job with
+// several tags is in the result once for every tag when grouping by tag.
+func JoinAggregate(query sq.SelectBuilder, aggreg model.Aggregate) sq.SelectBuilder {
+	if aggreg == model.AggregateTag {
+		// Not `jobtag` as BuildWhereClause joins that for the tag filter.
+		return query.Join("jobtag AS grouptag ON grouptag.job_id = job.id")
+	}
+	return query
+}
+
 // Build a sq.SelectBuilder out of a schema.JobFilter.
 func BuildWhereClause(filter *model.JobFilter, query sq.SelectBuilder) sq.SelectBuilder {
 	if filter.Tags != nil {
@@ -167,6 +191,37 @@ func BuildWhereClause(filter *model.JobFilter, query sq.SelectBuilder) sq.Select
 	return query
 }
 
+// If one of the filters has `groupByArray` set, only keep jobs that are not part of an
+// array job and the first matching member (lowest id) of every array job.
+func buildArrayGrouping(ctx context.Context, filters []*model.JobFilter, query sq.SelectBuilder) sq.SelectBuilder {
+	groupByArray := false
+	for _, f := range filters {
+		if f.GroupByArray != nil && *f.GroupByArray {
+			groupByArray = true
+		}
+	}
+	if !groupByArray {
+		return query
+	}
+
+	members := sq.Select("MIN(job.id)").From("job").Where("job.array_job_id != 0")
+	members = SecurityCheck(ctx, members)
+	for _, f := range filters {
+		members = BuildWhereClause(f, members)
+	}
+
+	sql, args, err := members.GroupBy("job.cluster", "job.array_job_id").ToSql()
+	if err != nil {
+		log.Warnf("building array job grouping failed: %s", err.Error())
+		return query
+	}
+
+	return query.Where(sq.Or{
+		sq.Eq{"job.array_job_id": 0},
+		sq.Expr("job.id IN ("+sql+")", args...),
+	})
+}
+
 func buildIntCondition(field string, cond *schema.IntRange, query sq.SelectBuilder) sq.SelectBuilder {
 	return query.Where(field+" BETWEEN ? AND ?", cond.From, cond.To)
 }
@@ -202,20 +257,3 @@ func buildStringCondition(field string, cond *model.StringInput, query sq.Select
 	}
 	return query
 }
-
-var matchFirstCap = regexp.MustCompile("(.)([A-Z][a-z]+)")
-var matchAllCap = regexp.MustCompile("([a-z0-9])([A-Z])")
-
-func toSnakeCase(str string) string {
-	for _, c := range str {
-		if c == '\'' || c == '\\' {
-			panic("A hacker (probably not)!!!")
-		}
-	}
-
-	str = strings.ReplaceAll(str, "'", "")
-	str = strings.ReplaceAll(str, "\\", "")
-	snake := matchFirstCap.ReplaceAllString(str, "${1}_${2}")
-	snake = matchAllCap.ReplaceAllString(snake, "${1}_${2}")
-	return strings.ToLower(snake)
-}
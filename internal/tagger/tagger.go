@@ -0,0 +1,321 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Package tagger tags jobs using the rules configured per cluster (see schema.JobRule).
+package tagger
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/ClusterCockpit/cc-backend/internal/config"
+	"github.com/ClusterCockpit/cc-backend/internal/expression"
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
+	"github.com/ClusterCockpit/cc-backend/internal/repository"
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/log"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+const (
+	defaultTagType = "rule"
+
+	// Prefix of the metadata keys the explanations are stored under.
+	metadataPrefix = "rule:"
+)
+
+type rule struct {
+	*schema.JobRule
+	expr *expression.Expression
+}
+
+// A Match is a rule that matched a job.
+type Match struct {
+	Rule        *schema.JobRule
+	TagType     string
+	TagName     string
+	Explanation string
+}
+
+// The rules per cluster.
+var rules map[string][]*rule = map[string][]*rule{}
+
+var jobAttributes = map[string]func(job *schema.Job) float64{
+	"numNodes":     func(job *schema.Job) float64 { return float64(job.NumNodes) },
+	"numHwthreads": func(job *schema.Job) float64 { return float64(job.NumHWThreads) },
+	"numAcc":       func(job *schema.Job) float64 { return float64(job.NumAcc) },
+	"duration":     func(job *schema.Job) float64 { return float64(job.Duration) },
+	"walltime":     func(job *schema.Job) float64 { return float64(job.Walltime) },
+	"exclusive":    func(job *schema.Job) float64 { return float64(job.Exclusive) },
+	"smt":          func(job *schema.Job) float64 { return float64(job.SMT) },
+}
+
+var metricValues = map[string]bool{
+	"avg": true, "min": true, "max": true,
+	"peak": true, "normal": true, "caution": true, "alert": true,
+}
+
+// Init parses the rules of all clusters. The job archive has to be initialized first.
+func Init() error {
+	rules = map[string][]*rule{}
+	for _, cluster := range config.Keys.Clusters {
+		for _, jr := range cluster.JobRules {
+			r, err := newRule(cluster.Name, jr)
+			if err != nil {
+				return fmt.Errorf("job rule '%s' of cluster '%s': %w", jr.Name, cluster.Name, err)
+			}
+			rules[cluster.Name] = append(rules[cluster.Name], r)
+		}
+	}
+	return nil
+}
+
+func newRule(cluster string, jr *schema.JobRule) (*rule, error) {
+	if jr.Name == "" {
+		return nil, fmt.Errorf("a name is required")
+	}
+
+	expr, err := expression.Parse(jr.Rule)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, operand := range expr.Operands {
+		parts := strings.Split(operand, ".")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid operand '%s' (expected '<metric>.<value>' or 'job.<attribute>')", operand)
+		}
+		if parts[0] == "job" {
+			if _, ok := jobAttributes[parts[1]]; !ok {
+				return nil, fmt.Errorf("unknown job attribute '%s'", parts[1])
+			}
+			continue
+		}
+		if archive.GetMetricConfig(cluster, parts[0]) == nil {
+			return nil, fmt.Errorf("unknown metric '%s'", parts[0])
+		}
+		if !metricValues[parts[1]] {
+			return nil, fmt.Errorf("unknown value '%s' of metric '%s'", parts[1], parts[0])
+		}
+	}
+
+	return &rule{JobRule: jr, expr: expr}, nil
+}
+
+// tag returns the type and name of the tag of the rule.
+func (r *rule) tag() (string, string) {
+	tagType, tagName := r.TagType, r.TagName
+	if tagType == "" {
+		tagType = defaultTagType
+	}
+	if tagName == "" {
+		tagName = r.Name
+	}
+	return tagType, tagName
+}
+
+// Evaluate returns the rules of the cluster of the job that match. Rules referencing statistics
+// the job does not have or thresholds that are not configured are skipped.
+func Evaluate(job *schema.Job, stats map[string]schema.JobStatistics) []Match {
+	matches, _ := evaluate(job, stats)
+	return matches
+}
+
+// evaluate is like Evaluate, but also returns the rules that were evaluated and did not match.
+func evaluate(job *schema.Job, stats map[string]schema.JobStatistics) ([]Match, []*rule) {
+	matches, unmatched := make([]Match, 0), make([]*rule, 0)
+	for _, r := range rules[job.Cluster] {
+		values := make([]float64, len(r.expr.Operands))
+		missing := false
+		for i, operand := range r.expr.Operands {
+			values[i] = operandValue(job, stats, operand)
+			if math.IsNaN(values[i]) {
+				missing = true
+				break
+			}
+		}
+		if missing {
+			continue
+		}
+		if !expression.IsTrue(r.expr.Eval(values)) {
+			unmatched = append(unmatched, r)
+			continue
+		}
+
+		m := Match{Rule: r.JobRule}
+		m.TagType, m.TagName = r.tag()
+
+		explanation := r.Explanation
+		if explanation == "" {
+			explanation = r.Rule
+		}
+		used := make([]string, 0, len(values))
+		for i, operand := range r.expr.Operands {
+			used = append(used, fmt.Sprintf("%s = %g", operand, values[i]))
+		}
+		m.Explanation = fmt.Sprintf("%s (%s)", explanation, strings.Join(used, ", "))
+
+		matches = append(matches, m)
+	}
+	return matches, unmatched
+}
+
+func operandValue(job *schema.Job, stats map[string]schema.JobStatistics, operand string) float64 {
+	parts := strings.SplitN(operand, ".", 2)
+	if parts[0] == "job" {
+		return jobAttributes[parts[1]](job)
+	}
+
+	switch parts[1] {
+	case "avg", "min", "max":
+		s, ok := stats[parts[0]]
+		if !ok {
+			return math.NaN()
+		}
+		switch parts[1] {
+		case "avg":
+			return s.Avg
+		case "min":
+			return s.Min
+		default:
+			return s.Max
+		}
+	}
+
+	mc := archive.GetMetricConfig(job.Cluster, parts[0])
+	if mc == nil {
+		return math.NaN()
+	}
+	thresholds := []*float64{mc.Peak, mc.Normal, mc.Caution, mc.Alert}
+	for _, sc := range mc.SubClusters {
+		if sc.Name != job.SubCluster {
+			continue
+		}
+		for i, threshold := range []*float64{sc.Peak, sc.Normal, sc.Caution, sc.Alert} {
+			if threshold != nil {
+				thresholds[i] = threshold
+			}
+		}
+	}
+
+	var threshold *float64
+	switch parts[1] {
+	case "peak":
+		threshold = thresholds[0]
+	case "normal":
+		threshold = thresholds[1]
+	case "caution":
+		threshold = thresholds[2]
+	case "alert":
+		threshold = thresholds[3]
+	}
+	if threshold == nil {
+		return math.NaN()
+	}
+	return *threshold
+}
+
+// TagJob evaluates the rules for a job and adds the tags of the matching rules (if the job does not have
+// them already). The explanation of every match is stored in the metadata of the job. The tags (unless
+// another rule with the same tag matched) and explanations of rules that did not match are removed.
+func TagJob(r *repository.JobRepository, job *schema.Job, stats map[string]schema.JobStatistics) ([]Match, error) {
+	matches, unmatched := evaluate(job, stats)
+	if len(matches) == 0 && len(unmatched) == 0 {
+		return matches, nil
+	}
+
+	tags, err := r.GetTags(&job.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make(map[[2]string]bool, len(matches))
+	for _, m := range matches {
+		matched[[2]string{m.TagType, m.TagName}] = true
+		hasTag := false
+		for _, tag := range tags {
+			if tag.Type == m.TagType && tag.Name == m.TagName {
+				hasTag = true
+			}
+		}
+
+		if !hasTag {
+			if _, err := r.AddTagOrCreate(job.ID, m.TagType, m.TagName); err != nil {
+				return nil, err
+			}
+		}
+		if err := r.UpdateMetadata(job, metadataPrefix+m.Rule.Name, m.Explanation); err != nil {
+			return nil, err
+		}
+	}
+
+	metadata, err := r.FetchMetadata(job)
+	if err != nil {
+		return nil, err
+	}
+	for _, rl := range unmatched {
+		tagType, tagName := rl.tag()
+		for _, tag := range tags {
+			if tag.Type == tagType && tag.Name == tagName && !matched[[2]string{tagType, tagName}] {
+				if _, err := r.RemoveTag(job.ID, tag.ID); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if _, ok := metadata[metadataPrefix+rl.Name]; ok {
+			if err := r.DeleteMetadata(job, metadataPrefix+rl.Name); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return matches, nil
+}
+
+// ApplyRules evaluates the rules for all archived jobs using the statistics in the job archive.
+// It returns the number of jobs that were tagged.
+func ApplyRules(r *repository.JobRepository) (int, error) {
+	const pageSize = 1000
+
+	// Keyset pagination on the id, tagging jobs does not shift the pages and no
+	// jobs are skipped (as with an offset) while new jobs are inserted.
+	order := []*model.OrderByInput{{Field: model.SortByAttributeID, Order: model.SortDirectionEnumAsc}}
+	tagged := 0
+	after := new(string)
+	for {
+		page, err := r.QueryJobsKeyset(context.Background(), []*model.JobFilter{}, pageSize, order, after, nil)
+		if err != nil {
+			return tagged, err
+		}
+
+		for _, job := range page.Jobs {
+			if len(rules[job.Cluster]) == 0 || job.State == schema.JobStateRunning ||
+				job.MonitoringStatus != schema.MonitoringStatusArchivingSuccessful {
+				continue
+			}
+
+			stats, err := archive.GetStatistics(job)
+			if err != nil {
+				log.Warnf("job rules: loading the statistics of job (dbid: %d) failed: %s", job.ID, err.Error())
+				continue
+			}
+
+			matches, err := TagJob(r, job, stats)
+			if err != nil {
+				return tagged, err
+			}
+			if len(matches) != 0 {
+				tagged += 1
+			}
+		}
+
+		if !page.HasNextPage {
+			return tagged, nil
+		}
+		after = &page.EndCursor
+	}
+}
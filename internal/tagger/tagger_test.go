@@ -0,0 +1,97 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package tagger
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ClusterCockpit/cc-backend/internal/config"
+	"github.com/ClusterCockpit/cc-backend/pkg/archive"
+	"github.com/ClusterCockpit/cc-backend/pkg/schema"
+)
+
+func setup(t *testing.T, jobRules ...*schema.JobRule) error {
+	if err := archive.Init(json.RawMessage(`{"kind": "file","path": "../../test/archive"}`), false); err != nil {
+		t.Fatal(err)
+	}
+
+	clusters := config.Keys.Clusters
+	t.Cleanup(func() { config.Keys.Clusters = clusters })
+	config.Keys.Clusters = []*schema.ClusterConfig{{Name: "emmy", JobRules: jobRules}}
+	return Init()
+}
+
+func TestInit(t *testing.T) {
+	invalid := []string{
+		"flops_any",
+		"foo.avg > 1",
+		"flops_any.median > 1",
+		"job.foo > 1",
+		"flops_any.avg >",
+	}
+	for _, rule := range invalid {
+		if err := setup(t, &schema.JobRule{Name: "test", Rule: rule}); err == nil {
+			t.Errorf("expected an error for %#v", rule)
+		}
+	}
+
+	if err := setup(t, &schema.JobRule{Rule: "flops_any.avg > 1"}); err == nil {
+		t.Errorf("expected an error for a rule without a name")
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	err := setup(t,
+		&schema.JobRule{Name: "lowflops", Rule: "flops_any.avg < 0.05 * flops_any.peak && job.duration > 3600", Explanation: "Low flop rate"},
+		&schema.JobRule{Name: "memory", Rule: "mem_used.max > mem_used.caution", TagType: "warning", TagName: "highmem"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	job := &schema.Job{BaseJob: schema.BaseJob{Cluster: "emmy", SubCluster: "main", Duration: 7200}}
+	matches := Evaluate(job, map[string]schema.JobStatistics{
+		"flops_any": {Avg: 10, Min: 5, Max: 20},
+	})
+	if len(matches) != 1 {
+		t.Fatalf("expected one match, got %d", len(matches))
+	}
+	m := matches[0]
+	if m.TagType != "rule" || m.TagName != "lowflops" {
+		t.Errorf("unexpected tag: %s:%s", m.TagType, m.TagName)
+	}
+	if m.Explanation != "Low flop rate (flops_any.avg = 10, flops_any.peak = 704, job.duration = 7200)" {
+		t.Errorf("unexpected explanation: %#v", m.Explanation)
+	}
+
+	job.Duration = 600
+	matches = Evaluate(job, map[string]schema.JobStatistics{
+		"flops_any": {Avg: 10, Min: 5, Max: 20},
+		"mem_used":  {Avg: 30, Min: 20, Max: 50},
+	})
+	if len(matches) != 1 || matches[0].TagType != "warning" || matches[0].TagName != "highmem" {
+		t.Errorf("unexpected matches: %#v", matches)
+	}
+}
+
+func TestSubClusterThresholds(t *testing.T) {
+	if err := setup(t, &schema.JobRule{Name: "lowflops", Rule: "flops_any.avg < flops_any.normal && flops_any.avg > flops_any.alert"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the alert threshold is overridden for the subcluster, normal is the one of the metric (100).
+	mc := archive.GetMetricConfig("emmy", "flops_any")
+	alert := 50.0
+	mc.SubClusters = []*schema.SubClusterConfig{{Name: "main", Alert: &alert}}
+	t.Cleanup(func() { mc.SubClusters = nil })
+
+	job := &schema.Job{BaseJob: schema.BaseJob{Cluster: "emmy", SubCluster: "main"}}
+	for avg, expected := range map[float64]int{10: 0, 60: 1, 120: 0} {
+		if matches := Evaluate(job, map[string]schema.JobStatistics{"flops_any": {Avg: avg}}); len(matches) != expected {
+			t.Errorf("expected %d matches for an average of %g, got %d", expected, avg, len(matches))
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path"
 	"path/filepath"
@@ -206,23 +207,39 @@ func (fsa *FsArchive) ImportJob(
 		return err
 	}
 
-	f, err := os.Create(path.Join(dir, "meta.json"))
-	if err != nil {
-		return err
-	}
-	if err := EncodeJobMeta(f, jobMeta); err != nil {
+	if err := writeFile(path.Join(dir, "meta.json"), func(w io.Writer) error {
+		return EncodeJobMeta(w, jobMeta)
+	}); err != nil {
 		return err
 	}
-	if err := f.Close(); err != nil {
+
+	// The data of a job that is archived again must not be served from the cache.
+	filename := getPath(&job, fsa.path, "data.json")
+	defer cache.Del(filename)
+
+	return writeFile(filename, func(w io.Writer) error {
+		return EncodeJobData(w, jobData)
+	})
+}
+
+// writeFile writes to a temporary file that replaces `filename` once it is complete,
+// so that a job archived again keeps its old files if writing fails.
+func writeFile(filename string, encode func(w io.Writer) error) error {
+	tmp := filename + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
 		return err
 	}
 
-	f, err = os.Create(path.Join(dir, "data.json"))
-	if err != nil {
+	if err := encode(f); err != nil {
+		f.Close()
+		os.Remove(tmp)
 		return err
 	}
-	if err := EncodeJobData(f, jobData); err != nil {
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
 		return err
 	}
-	return f.Close()
+
+	return os.Rename(tmp, filename)
 }
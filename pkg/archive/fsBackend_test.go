@@ -6,7 +6,11 @@ package archive
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -161,3 +165,44 @@ func TestIter(t *testing.T) {
 		}
 	}
 }
+
+func TestImportJob(t *testing.T) {
+	fsa := &FsArchive{path: t.TempDir()}
+
+	jobMeta := &schema.JobMeta{BaseJob: schema.JobDefaults, StartTime: 1608923076}
+	jobMeta.JobID = 1403244
+	jobMeta.Cluster = "emmy"
+	jobData := schema.JobData{"load_one": {schema.MetricScopeNode: &schema.JobMetric{
+		Scope:  schema.MetricScopeNode,
+		Series: []schema.Series{{Hostname: "e0101", Data: []schema.Float{1, 2, 3}}},
+	}}}
+	if err := fsa.ImportJob(jobMeta, &jobData); err != nil {
+		t.Fatal(err)
+	}
+
+	job := &schema.Job{BaseJob: jobMeta.BaseJob, StartTime: time.Unix(jobMeta.StartTime, 0)}
+	filename := getPath(job, fsa.path, "data.json")
+	files, err := filepath.Glob(filepath.Join(filepath.Dir(filename), "*"))
+	if err != nil || len(files) != 2 {
+		t.Fatalf("expected meta.json and data.json only, got: %v, %v", files, err)
+	}
+
+	// A failed write keeps the old file.
+	if err := writeFile(filename, func(w io.Writer) error {
+		fmt.Fprint(w, "{")
+		return errors.New("encoding failed")
+	}); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	data, err := fsa.LoadJobData(job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := data["load_one"][schema.MetricScopeNode].Series[0]; s.Data[2] != 3 {
+		t.Errorf("unexpected data: %#v", s)
+	}
+	if _, err := os.Stat(filename + ".tmp"); !os.IsNotExist(err) {
+		t.Errorf("expected the temporary file to be removed: %v", err)
+	}
+}
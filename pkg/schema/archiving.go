@@ -0,0 +1,71 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package schema
+
+import "time"
+
+// ArchivingTask model
+// @Description An entry of the archiving queue. Entries are removed once the job was archived successfully.
+type ArchivingTask struct {
+	// The unique identifier of the entry in the database
+	ID              int64          `json:"id" db:"id"`
+	JobID           int64          `json:"jobDbId" db:"job_id" example:"123000"`     // The database id of the job to archive
+	State           ArchivingState `json:"state" db:"state" example:"pending"`       // State of the entry
+	Attempts        int            `json:"attempts" db:"attempts" example:"1"`       // Number of archiving attempts so far
+	LastError       string         `json:"lastError,omitempty" db:"last_error"`      // Error of the last failed attempt
+	NextAttemptUnix int64          `json:"-" db:"next_attempt" example:"1649723812"` // Epoch time stamp of the next attempt in seconds
+	NextAttempt     time.Time      `json:"nextAttempt"`                              // Time of the next attempt (if pending) as 'time.Time' data type
+	CreatedAtUnix   int64          `json:"-" db:"created_at" example:"1649723812"`   // Epoch time stamp of the creation of the entry in seconds
+	CreatedAt       time.Time      `json:"createdAt"`                                // Time the entry was created as 'time.Time' data type
+}
+
+type ArchivingState string
+
+const (
+	ArchivingStatePending   ArchivingState = "pending"   // Waiting for the next attempt
+	ArchivingStateRunning   ArchivingState = "running"   // An attempt is in progress
+	ArchivingStateFailed    ArchivingState = "failed"    // All attempts failed
+	ArchivingStateAbandoned ArchivingState = "abandoned" // Given up by an administrator
+)
+
+func (e ArchivingState) Valid() bool {
+	return e == ArchivingStatePending ||
+		e == ArchivingStateRunning ||
+		e == ArchivingStateFailed ||
+		e == ArchivingStateAbandoned
+}
+
+// RearchiveResult model
+// @Description Outcome of archiving one job again.
+type RearchiveResult struct {
+	ID         int64                    `json:"id"`                                       // The unique identifier of a job in the database
+	JobID      int64                    `json:"jobId" example:"123000"`                   // The unique identifier of a job
+	Cluster    string                   `json:"cluster" example:"fritz"`                  // The unique identifier of a cluster
+	StartTime  int64                    `json:"startTime" example:"1649723812"`           // Start epoch time stamp in seconds
+	Statistics map[string]JobStatistics `json:"statistics,omitempty"`                     // Recomputed metric statistics of the job
+	Skipped    bool                     `json:"skipped,omitempty"`                        // The job was not archived again (see error)
+	Error      string                   `json:"error,omitempty" example:"no metric data"` // Why the job was skipped or archiving it failed
+}
+
+// RearchiveSummary model
+// @Description Number of jobs archived again.
+type RearchiveSummary struct {
+	Total   int `json:"total"`   // Number of jobs matching the filters
+	Done    int `json:"done"`    // Jobs archived again (or that would be in a dry run)
+	Skipped int `json:"skipped"` // Jobs that are not archived yet or whose data is not available anymore
+	Failed  int `json:"failed"`  // Jobs where loading the data or updating the archive failed
+}
+
+// RearchiveRun model
+// @Description Progress of archiving jobs again in the background.
+type RearchiveRun struct {
+	DryRun    bool               `json:"dryRun"`          // Only the statistics are computed
+	Running   bool               `json:"running"`         // The run is still in progress
+	StartTime time.Time          `json:"startTime"`       // Time the run was started
+	Processed int                `json:"processed"`       // Number of jobs processed so far
+	Summary   RearchiveSummary   `json:"summary"`         // Outcome so far
+	Results   []*RearchiveResult `json:"results"`         // The results of the latest jobs processed
+	Error     string             `json:"error,omitempty"` // Why the run was aborted
+}
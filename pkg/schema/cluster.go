@@ -35,12 +35,14 @@ type SubCluster struct {
 	Topology        *Topology `json:"topology"`
 }
 
+// Thresholds of a metric for one subcluster. Thresholds that are not set
+// fall back to the ones of the metric.
 type SubClusterConfig struct {
-	Name    string  `json:"name"`
-	Peak    float64 `json:"peak"`
-	Normal  float64 `json:"normal"`
-	Caution float64 `json:"caution"`
-	Alert   float64 `json:"alert"`
+	Name    string   `json:"name"`
+	Peak    *float64 `json:"peak"`
+	Normal  *float64 `json:"normal"`
+	Caution *float64 `json:"caution"`
+	Alert   *float64 `json:"alert"`
 }
 
 type MetricConfig struct {
@@ -54,6 +56,10 @@ type MetricConfig struct {
 	Caution     *float64            `json:"caution"`
 	Alert       *float64            `json:"alert"`
 	SubClusters []*SubClusterConfig `json:"subClusters"`
+
+	// If set, this metric is not loaded from the metric data repository, but derived
+	// from other metrics of the same cluster, e.g. `flops_dp*2 + flops_sp`.
+	Expression string `json:"expression,omitempty"`
 }
 
 type Cluster struct {
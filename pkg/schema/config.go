@@ -51,10 +51,60 @@ type FilterRanges struct {
 	StartTime *TimeRange `json:"startTime"`
 }
 
+// Which metric data of a job is written to the job-archive. Unset options of the
+// policies in `Metrics` are taken from the cluster wide policy.
+type ArchivePolicy struct {
+	// Scopes that are archived if available (default: node and core, accelerator for jobs with accelerators).
+	// The node scope is always archived.
+	Scopes []MetricScope `json:"scopes,omitempty"`
+
+	// For jobs with more nodes than this, only the node scope is archived (default: 8).
+	MaxNodes *int `json:"maxNodes,omitempty"`
+
+	// If larger than the timestep of a metric, the data is downsampled to this timestep (in seconds)
+	// by averaging. Statistics are always calculated from the full resolution.
+	Timestep int `json:"timestep,omitempty"`
+
+	// Per metric overrides.
+	Metrics map[string]*ArchivePolicy `json:"metrics,omitempty"`
+}
+
+// A rule that tags matching jobs when they are archived. The rule is an expression over the
+// statistics of the job (`<metric>.avg`, `<metric>.min`, `<metric>.max`), the thresholds of the
+// metrics (`<metric>.peak`, `<metric>.normal`, `<metric>.caution`, `<metric>.alert`) and the
+// job (`job.numNodes`, `job.numHwthreads`, `job.numAcc`, `job.duration`, `job.walltime`,
+// `job.exclusive`, `job.smt`), e.g. `flops_any.avg < 0.1 * flops_any.peak && job.duration > 3600`.
+type JobRule struct {
+	Name string `json:"name"`
+	Rule string `json:"rule"`
+
+	// The tag added to matching jobs (default type: "rule", default name: the name of the rule).
+	TagType string `json:"tagType,omitempty"`
+	TagName string `json:"tagName,omitempty"`
+
+	// Stored in the metadata of matching jobs together with the values the rule was evaluated with.
+	Explanation string `json:"explanation,omitempty"`
+}
+
+// Configuration of the embedded metric store (see the metric data repository kind `embedded`).
+type MetricStoreConfig struct {
+	// How long metric data is kept in memory as parsed by time.ParseDuration (default: "48h").
+	Retention string `json:"retention"`
+
+	// If not empty, the data is saved to this directory periodically and on shutdown
+	// and restored on startup.
+	CheckpointDir string `json:"checkpoint-dir"`
+
+	// How often the data is saved as parsed by time.ParseDuration (default: "1h").
+	CheckpointInterval string `json:"checkpoint-interval"`
+}
+
 type ClusterConfig struct {
 	Name                 string          `json:"name"`
 	FilterRanges         *FilterRanges   `json:"filterRanges"`
 	MetricDataRepository json.RawMessage `json:"metricDataRepository"`
+	ArchivePolicy        *ArchivePolicy  `json:"archivePolicy"`
+	JobRules             []*JobRule      `json:"jobRules"`
 }
 
 // Format of the configuration (file). See below for the defaults.
@@ -116,6 +166,17 @@ type ProgramConfig struct {
 	// If not zero, automatically mark jobs as stopped running X seconds longer than their walltime.
 	StopJobsExceedingWalltime int `json:"stop-jobs-exceeding-walltime"`
 
+	// Number of jobs archived in parallel and how often archiving a job is attempted
+	// before it is marked as failed (with exponential backoff in between).
+	ArchiveWorkers     int `json:"archive-workers"`
+	ArchiveMaxAttempts int `json:"archive-max-attempts"`
+
+	// Jobs running less than this many seconds count as short jobs in the statistics.
+	ShortJobDuration int `json:"short-job-duration"`
+
+	// If not nil, metric data can be sent to the `/api/write/` endpoint and is kept in memory.
+	MetricStore *MetricStoreConfig `json:"metric-store"`
+
 	// Array of Clusters
 	Clusters []*ClusterConfig `json:"clusters"`
 }
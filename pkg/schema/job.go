@@ -73,8 +73,17 @@ type JobMeta struct {
 	// The unique identifier of a job in the database
 	ID *int64 `json:"id,omitempty"`
 	BaseJob
-	StartTime  int64                    `json:"startTime" db:"start_time" example:"1649723812" minimum:"1"` // Start epoch time stamp in seconds (Min > 0)
-	Statistics map[string]JobStatistics `json:"statistics,omitempty"`                                       // Metric statistics of job
+	StartTime  int64                     `json:"startTime" db:"start_time" example:"1649723812" minimum:"1"` // Start epoch time stamp in seconds (Min > 0)
+	Statistics map[string]JobStatistics  `json:"statistics,omitempty"`                                       // Metric statistics of job
+	Archived   map[string]ArchivedMetric `json:"archived,omitempty"`                                         // Scopes and resolution of the archived metric data
+}
+
+// ArchivedMetric model
+// @Description Which metric data was written to the job-archive according to the archive policy of the cluster.
+type ArchivedMetric struct {
+	Scopes   []MetricScope `json:"scopes"`                // Archived scopes
+	Timestep int           `json:"timestep" example:"60"` // Timestep of the archived data in seconds
+	MaxNodes int           `json:"maxNodes" example:"8"`  // Finer scopes than node were dropped for jobs with more nodes
 }
 
 const (
@@ -0,0 +1,74 @@
+// Copyright (C) 2022 NHR@FAU, University Erlangen-Nuremberg.
+// All rights reserved.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Non-Swaggered Comment: Node
+// Non-Swaggered Comment: This type is used as the GraphQL interface and using sqlx as a table row.
+
+// Node model
+// @Description Current state of a cluster node.
+type Node struct {
+	// The unique identifier of a node in the database
+	ID            int64     `json:"id" db:"id"`
+	Hostname      string    `json:"hostname" db:"hostname" example:"f0101"`                // Hostname of the node
+	Cluster       string    `json:"cluster" db:"cluster" example:"fritz"`                  // The unique identifier of a cluster
+	SubCluster    string    `json:"subCluster" db:"subcluster" example:"main"`             // The unique identifier of a sub cluster
+	NodeState     NodeState `json:"nodeState" db:"node_state" example:"idle"`              // Current state of the node
+	Reason        string    `json:"reason,omitempty" db:"reason" example:"Not responding"` // Reason for the current state as reported by the scheduler or health check
+	TimeStampUnix int64     `json:"-" db:"time_stamp" example:"1649723812"`                // Epoch time stamp of the last state change in seconds
+	TimeStamp     time.Time `json:"timeStamp"`                                             // Time of the last state change as 'time.Time' data type
+}
+
+// NodeStateChange model
+// @Description One entry in the state history of a node.
+type NodeStateChange struct {
+	NodeState     NodeState `json:"nodeState" db:"node_state" example:"down"` // State the node changed to
+	Reason        string    `json:"reason,omitempty" db:"reason"`             // Reason for the state change
+	TimeStampUnix int64     `json:"-" db:"time_stamp"`                        // Epoch time stamp of the state change in seconds
+	TimeStamp     time.Time `json:"timeStamp"`                                // Time of the state change as 'time.Time' data type
+}
+
+type NodeState string
+
+const (
+	NodeStateIdle        NodeState = "idle"
+	NodeStateAllocated   NodeState = "allocated"
+	NodeStateDown        NodeState = "down"
+	NodeStateDrain       NodeState = "drain"
+	NodeStateMaintenance NodeState = "maintenance"
+)
+
+func (e *NodeState) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NodeState(str)
+	if !e.Valid() {
+		return errors.New("invalid node state")
+	}
+
+	return nil
+}
+
+func (e NodeState) MarshalGQL(w io.Writer) {
+	fmt.Fprintf(w, "\"%s\"", e)
+}
+
+func (e NodeState) Valid() bool {
+	return e == NodeStateIdle ||
+		e == NodeStateAllocated ||
+		e == NodeStateDown ||
+		e == NodeStateDrain ||
+		e == NodeStateMaintenance
+}
@@ -31,6 +31,29 @@ func TestValidateConfig(t *testing.T) {
 	}
 }
 
+func TestValidateConfigFailover(t *testing.T) {
+	json := []byte(`{
+	"clusters": [
+	{
+	   "name": "testcluster",
+	   "metricDataRepository": [
+		{"kind": "cc-metric-store", "url": "localhost:8082"},
+		{"kind": "influxdb", "name": "fallback", "url": "localhost:8086"}
+	   ],
+	   "filterRanges": {
+		"numNodes": { "from": 1, "to": 64 },
+		"duration": { "from": 0, "to": 86400 },
+		"startTime": { "from": "2022-01-01T00:00:00Z", "to": null }
+	}
+	}
+	]
+}`)
+
+	if err := Validate(Config, bytes.NewReader(json)); err != nil {
+		t.Errorf("Error is not nil! %v", err)
+	}
+}
+
 func TestValidateJobMeta(t *testing.T) {
 
 }
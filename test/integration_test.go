@@ -3,8 +3,11 @@ package test
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -13,12 +16,19 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/ClusterCockpit/cc-backend/internal/api"
+	"github.com/ClusterCockpit/cc-backend/internal/archiver"
+	"github.com/ClusterCockpit/cc-backend/internal/auth"
 	"github.com/ClusterCockpit/cc-backend/internal/config"
 	"github.com/ClusterCockpit/cc-backend/internal/graph"
+	"github.com/ClusterCockpit/cc-backend/internal/graph/generated"
+	"github.com/ClusterCockpit/cc-backend/internal/graph/model"
 	"github.com/ClusterCockpit/cc-backend/internal/metricdata"
 	"github.com/ClusterCockpit/cc-backend/internal/repository"
+	"github.com/ClusterCockpit/cc-backend/internal/tagger"
 	"github.com/ClusterCockpit/cc-backend/pkg/archive"
 	"github.com/ClusterCockpit/cc-backend/pkg/schema"
 	"github.com/gorilla/mux"
@@ -470,7 +480,7 @@ func TestRestApi(t *testing.T) {
 	}
 
 	t.Run("CheckArchive", func(t *testing.T) {
-		data, err := metricdata.LoadData(stoppedJob, []string{"load_one"}, []schema.MetricScope{schema.MetricScopeNode}, context.Background())
+		data, err := metricdata.LoadData(stoppedJob, []string{"load_one"}, []schema.MetricScope{schema.MetricScopeNode}, context.Background(), 0)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -480,6 +490,14 @@ func TestRestApi(t *testing.T) {
 		}
 	})
 
+	t.Run("Rearchive", func(t *testing.T) {
+		subtestRearchive(t, stoppedJob, r)
+	})
+
+	t.Run("JobRules", func(t *testing.T) {
+		subtestJobRules(t, restapi, stoppedJob)
+	})
+
 	t.Run("CheckDoubleStart", func(t *testing.T) {
 		// Starting a job with the same jobId and cluster should only be allowed if the startTime is far appart!
 		body := strings.Replace(startJobBody, `"startTime": 123456789`, `"startTime": 123456790`, -1)
@@ -498,9 +516,691 @@ func TestRestApi(t *testing.T) {
 		subtestLetJobFail(t, restapi, r)
 	})
 
+	t.Run("CompareJobs", func(t *testing.T) {
+		subtestCompareJobs(t, restapi, dbid)
+	})
+
+	t.Run("JobsHistogram", func(t *testing.T) {
+		subtestJobsHistogram(t, restapi)
+	})
+
+	t.Run("ClusterUtilization", func(t *testing.T) {
+		subtestClusterUtilization(t, restapi)
+	})
+
+	t.Run("JobsStatistics", func(t *testing.T) {
+		subtestJobsStatistics(t, restapi)
+	})
+
 	t.Run("ImportJob", func(t *testing.T) {
 		testImportFlag(t)
 	})
+
+	t.Run("NodeState", func(t *testing.T) {
+		subtestNodeState(t, restapi, r)
+	})
+
+	t.Run("ArrayJob", func(t *testing.T) {
+		subtestArrayJob(t, restapi, r)
+	})
+
+	t.Run("BackupRestore", func(t *testing.T) {
+		subtestBackupRestore(t, restapi, r)
+	})
+
+	t.Run("ArchivingQueue", func(t *testing.T) {
+		subtestArchivingQueue(t, r)
+	})
+}
+
+func subtestRearchive(t *testing.T, job *schema.Job, r *mux.Router) {
+	loadData := metricdata.TestLoadDataCallback
+	defer func() { metricdata.TestLoadDataCallback = loadData }()
+
+	// The metric data repository now has other data for the job:
+	metricdata.TestLoadDataCallback = func(job *schema.Job, metrics []string, scopes []schema.MetricScope, ctx context.Context) (schema.JobData, error) {
+		return schema.JobData{
+			"load_one": map[schema.MetricScope]*schema.JobMetric{
+				schema.MetricScopeNode: {
+					Unit:     "load",
+					Scope:    schema.MetricScopeNode,
+					Timestep: 60,
+					Series:   []schema.Series{{Hostname: "host123", Data: []schema.Float{1, 1, 1, 2, 2, 2, 3, 3, 3}}},
+				},
+			},
+		}, nil
+	}
+
+	loadOne := func() schema.Float {
+		data, err := metricdata.LoadData(job, []string{"load_one"}, []schema.MetricScope{schema.MetricScopeNode}, context.Background(), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data["load_one"][schema.MetricScopeNode].Series[0].Data[0]
+	}
+
+	filter := []*model.JobFilter{{JobID: &model.StringInput{Eq: &[]string{"123"}[0]}}}
+	summary, err := archiver.Rearchive(context.Background(), filter, true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.Total != 1 || summary.Done != 1 || loadOne() != 0.1 {
+		t.Fatalf("unexpected dry run: %#v", summary)
+	}
+
+	request := func(method, body string) (*http.Response, *schema.RearchiveRun) {
+		req := httptest.NewRequest(method, "/api/jobs/rearchive/", bytes.NewBuffer([]byte(body)))
+		recorder := httptest.NewRecorder()
+
+		r.ServeHTTP(recorder, req)
+		run := &schema.RearchiveRun{}
+		json.NewDecoder(recorder.Body).Decode(run)
+		return recorder.Result(), run
+	}
+
+	if response, _ := request(http.MethodPost, `{"filter": {"jobId": {"eq": "123"}}}`); response.StatusCode != http.StatusAccepted {
+		t.Fatal(response.Status)
+	}
+	for i := 0; ; i++ {
+		response, run := request(http.MethodGet, "")
+		if response.StatusCode != http.StatusOK {
+			t.Fatal(response.Status)
+		}
+		if !run.Running {
+			if run.Summary.Done != 1 || len(run.Results) != 1 || run.Results[0].Statistics["load_one"].Avg != 2 {
+				t.Fatalf("unexpected run: %#v", run)
+			}
+			break
+		} else if i == 50 {
+			t.Fatal("re-archiving did not finish")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if loadOne() != 1 {
+		t.Fatal("expected the archived data to be replaced")
+	}
+
+	// Incomplete or expired data must not replace the archived data, the job is skipped.
+	loadOneOf := func(hostname string, value schema.Float) schema.JobData {
+		return schema.JobData{
+			"load_one": map[schema.MetricScope]*schema.JobMetric{
+				schema.MetricScopeNode: {
+					Unit:     "load",
+					Scope:    schema.MetricScopeNode,
+					Timestep: 60,
+					Series:   []schema.Series{{Hostname: hostname, Data: []schema.Float{value, value, value}}},
+				},
+			},
+		}
+	}
+	for name, callback := range map[string]func() (schema.JobData, error){
+		"partial":      func() (schema.JobData, error) { return loadOneOf("host123", 5), errors.New("mem_used: no data") },
+		"missing host": func() (schema.JobData, error) { return loadOneOf("host999", 5), nil },
+		"expired":      func() (schema.JobData, error) { return schema.JobData{}, errors.New("load_one: no data") },
+	} {
+		metricdata.TestLoadDataCallback = func(job *schema.Job, metrics []string, scopes []schema.MetricScope, ctx context.Context) (schema.JobData, error) {
+			return callback()
+		}
+
+		summary, err := archiver.Rearchive(context.Background(), filter, false, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if summary.Skipped != 1 || summary.Done != 0 || summary.Failed != 0 {
+			t.Errorf("%s: expected the job to be skipped: %#v", name, summary)
+		}
+		if loadOne() != 1 {
+			t.Fatalf("%s: the archived data was replaced", name)
+		}
+	}
+}
+
+// Re-evaluating the rules removes the tag and explanation of a rule that does not match anymore.
+func subtestJobRules(t *testing.T, restapi *api.RestApi, job *schema.Job) {
+	cluster := config.Keys.Clusters[0]
+	defer func() {
+		cluster.JobRules = nil
+		tagger.Init()
+	}()
+	cluster.JobRules = []*schema.JobRule{{Name: "highload", Rule: "load_one.avg > load_one.normal"}}
+	if err := tagger.Init(); err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(stats map[string]schema.JobStatistics, expected bool) {
+		if _, err := tagger.TagJob(restapi.JobRepository, job, stats); err != nil {
+			t.Fatal(err)
+		}
+
+		tags, err := restapi.JobRepository.GetTags(&job.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hasTag := false
+		for _, tag := range tags {
+			hasTag = hasTag || (tag.Type == "rule" && tag.Name == "highload")
+		}
+		metadata, err := restapi.JobRepository.FetchMetadata(job)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, hasExplanation := metadata["rule:highload"]; hasTag != expected || hasExplanation != expected {
+			t.Fatalf("expected tag and explanation: %v, got: %v, %v", expected, tags, metadata)
+		}
+	}
+
+	check(map[string]schema.JobStatistics{"load_one": {Avg: 2}}, true)
+	check(map[string]schema.JobStatistics{"load_one": {Avg: 0}}, false)
+}
+
+// Compares the re-archived job (load_one avg: 2) with the failed job (avg: 0.2).
+func subtestCompareJobs(t *testing.T, restapi *api.RestApi, dbid int64) {
+	jobid, cluster := int64(12345), "testcluster"
+	failedJob, err := restapi.JobRepository.Find(&jobid, &cluster, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := []string{strconv.FormatInt(dbid, 10), strconv.FormatInt(failedJob.ID, 10)}
+	averageNodes := true
+	res, err := restapi.Resolver.Query().CompareJobs(context.Background(), ids, []string{"load_one"}, &averageNodes, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Jobs) != 2 || len(res.Metrics) != 1 {
+		t.Fatalf("unexpected comparison: %#v", res)
+	}
+	mc := res.Metrics[0]
+	if mc.Name != "load_one" || mc.Statistics[0].Avg != 2 || mc.Statistics[1].Avg != 0.2 {
+		t.Fatalf("unexpected statistics: %#v", mc)
+	}
+	if mc.RelativeDiff[0] != 0 || math.Abs(float64(mc.RelativeDiff[1])+0.9) > 1e-9 {
+		t.Errorf("unexpected relative differences: %v", mc.RelativeDiff)
+	}
+	if len(mc.Series) != 2 || mc.Series[1].Job != ids[1] || mc.Series[1].Hostname != nil || mc.Series[1].Data[8] != 0.3 {
+		t.Errorf("unexpected series: %#v", mc.Series)
+	}
+
+	tooMany := make([]string, graph.MAX_JOBS_FOR_ANALYSIS+1)
+	for i := range tooMany {
+		tooMany[i] = ids[i%2]
+	}
+	if _, err := restapi.Resolver.Query().CompareJobs(context.Background(), tooMany, nil, nil, nil); err == nil {
+		t.Error("expected too many jobs to be rejected")
+	}
+
+	// Only the owner may compare the jobs:
+	ctx := context.WithValue(context.Background(), auth.ContextUserKey, &auth.User{Username: "otheruser", Roles: []string{auth.RoleUser}})
+	if _, err := restapi.Resolver.Query().CompareJobs(ctx, ids, nil, nil, nil); err == nil {
+		t.Error("expected the jobs of another user to be rejected")
+	}
+}
+
+func subtestJobsHistogram(t *testing.T, restapi *api.RestApi) {
+	cluster := "testcluster"
+	filter := []*model.JobFilter{{Cluster: &model.StringInput{Eq: &cluster}}}
+	count, err := restapi.JobRepository.CountJobs(context.Background(), filter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	width := 1.0
+	histograms, err := restapi.Resolver.Query().JobsHistogram(context.Background(), filter, "numNodes", nil, &model.HistogramBins{Width: &width}, []float64{50}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(histograms) != 1 || histograms[0].Count != count || len(histograms[0].Percentiles) != 1 {
+		t.Fatalf("unexpected histograms: %#v", histograms)
+	}
+	binned := 0
+	for _, bin := range histograms[0].Bins {
+		binned += bin.Count
+		if bin.To-bin.From != 1 {
+			t.Errorf("unexpected bin: %#v", bin)
+		}
+	}
+	if binned != count {
+		t.Errorf("expected %d jobs in the bins, got %d", count, binned)
+	}
+
+	logScale, bins := true, 4
+	histograms, err = restapi.Resolver.Query().JobsHistogram(context.Background(), filter, "duration", nil, &model.HistogramBins{Count: &bins, Log: &logScale}, nil, &[]model.Aggregate{model.AggregateUser}[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(histograms) == 0 || histograms[0].ID != "testuser" || len(histograms[0].Bins) != 4 || len(histograms[0].Percentiles) != 5 {
+		t.Fatalf("unexpected histograms: %#v", histograms)
+	}
+
+	if _, err := restapi.Resolver.Query().JobsHistogram(context.Background(), filter, "job.user", nil, nil, nil, nil); err == nil {
+		t.Error("expected an error for an unknown value")
+	}
+
+	// The archived statistics of load_one are 1/2/3 (the re-archived job) and 0.1/0.2/0.3 (the failed job).
+	for statistic, expected := range map[model.MetricStatistic][2]float64{
+		model.MetricStatisticMin: {0.1, 1},
+		model.MetricStatisticAvg: {0.2, 2},
+		model.MetricStatisticMax: {0.3, 3},
+	} {
+		statistic := statistic
+		histograms, err = restapi.Resolver.Query().JobsHistogram(context.Background(), filter, "load_one", &statistic, nil, []float64{0, 100}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(histograms) != 1 || histograms[0].Count != 2 ||
+			math.Abs(histograms[0].Percentiles[0].Value-expected[0]) > 1e-9 || math.Abs(histograms[0].Percentiles[1].Value-expected[1]) > 1e-9 {
+			t.Errorf("unexpected histogram of the %s of load_one: %#v", statistic, histograms)
+		}
+	}
+
+	max := model.MetricStatisticMax
+	if _, err := restapi.Resolver.Query().JobsHistogram(context.Background(), filter, "numNodes", &max, nil, nil, nil); err == nil {
+		t.Error("expected an error for a statistic of a job property")
+	}
+}
+
+// The job started by TestRestApi ran from 123456789 to 123457789 on one exclusive node with four cores.
+func subtestClusterUtilization(t *testing.T, restapi *api.RestApi) {
+	from, to := time.Unix(123456000, 0), time.Unix(123458000, 0)
+	res, err := restapi.Resolver.Query().ClusterUtilization(context.Background(), "testcluster", nil, from, to, 600, &[]model.Aggregate{model.AggregateUser}[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Series) != 1 || res.Series[0].ID != "testuser" || len(res.Series[0].Nodes) != 4 {
+		t.Fatalf("unexpected utilization: %#v", res)
+	}
+	expected := []float64{0, 411.0 / 600, 589.0 / 600, 0}
+	for i, nodes := range res.Series[0].Nodes {
+		if math.Abs(nodes-expected[i]) > 1e-9 || math.Abs(res.Series[0].Cores[i]-4*expected[i]) > 1e-9 {
+			t.Fatalf("unexpected utilization: %v nodes, %v cores", res.Series[0].Nodes, res.Series[0].Cores)
+		}
+	}
+
+	// Smaller buckets, the job covers most of them completely:
+	res, err = restapi.Resolver.Query().ClusterUtilization(context.Background(), "testcluster", nil, time.Unix(123456700, 0), to, 100, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodeSeconds := 0.0
+	for _, nodes := range res.Series[0].Nodes {
+		nodeSeconds += nodes * 100
+	}
+	if nodes := res.Series[0].Nodes; len(nodes) != 13 || nodes[5] != 1 || math.Abs(nodeSeconds-1000) > 1e-6 {
+		t.Fatalf("unexpected utilization: %v nodes", nodes)
+	}
+
+	// Other users do not see the job:
+	ctx := context.WithValue(context.Background(), auth.ContextUserKey, &auth.User{Username: "otheruser", Roles: []string{auth.RoleUser}})
+	res, err = restapi.Resolver.Query().ClusterUtilization(ctx, "testcluster", nil, from, to, 600, nil)
+	if err != nil || len(res.Series) != 0 {
+		t.Fatalf("unexpected utilization: %#v (%v)", res, err)
+	}
+}
+
+// The job started by TestRestApi started 1973-11-29 21:33:09 UTC, the failed job 1970-05-23 21:21:18 UTC.
+func subtestJobsStatistics(t *testing.T, restapi *api.RestApi) {
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: restapi.Resolver}))
+	query := func(args string) []*model.JobsStatistics {
+		body, _ := json.Marshal(map[string]string{
+			"query": `{ jobsStatistics(` + args + `) {
+				id bucket totalJobs totalWalltime totalCoreHours histNumNodes { value count } } }`,
+		})
+		req := httptest.NewRequest(http.MethodPost, "/query", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		srv.ServeHTTP(recorder, req)
+
+		var res struct {
+			Data struct {
+				JobsStatistics []*model.JobsStatistics `json:"jobsStatistics"`
+			} `json:"data"`
+			Errors []interface{} `json:"errors"`
+		}
+		if err := json.NewDecoder(recorder.Body).Decode(&res); err != nil || len(res.Errors) != 0 {
+			t.Fatalf("query failed: %v %v", err, res.Errors)
+		}
+		return res.Data.JobsStatistics
+	}
+
+	stats := query(`filter: [{cluster: {eq: "testcluster"}}], groupBy: PROJECT, timeBucket: {size: MONTH}`)
+	if len(stats) != 2 || stats[0].ID != "testproj" ||
+		!stats[0].Bucket.Equal(time.Date(1970, 5, 1, 0, 0, 0, 0, time.UTC)) ||
+		!stats[1].Bucket.Equal(time.Date(1973, 11, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected statistics: %#v", stats)
+	}
+	// One exclusive node with four cores for 1000 seconds:
+	if stats[1].TotalJobs != 1 || stats[1].TotalCoreHours != 1 || len(stats[1].HistNumNodes) != 1 || stats[1].HistNumNodes[0].Count != 1 {
+		t.Fatalf("unexpected statistics: %#v", stats[1])
+	}
+
+	// In Tokyo, the job started on 1973-11-30:
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	stats = query(`filter: [{cluster: {eq: "testcluster"}, jobId: {eq: "123"}}], timeBucket: {size: DAY, timeZone: "Asia/Tokyo"}`)
+	if len(stats) != 1 || !stats[0].Bucket.Equal(time.Date(1973, 11, 30, 0, 0, 0, 0, tokyo)) {
+		t.Fatalf("unexpected statistics: %#v", stats)
+	}
+
+	// Monday:
+	stats = query(`filter: [{cluster: {eq: "testcluster"}}], groupBy: SUBCLUSTER, timeBucket: {size: WEEK}`)
+	if len(stats) != 2 || stats[1].ID != "sc1" || !stats[1].Bucket.Equal(time.Date(1973, 11, 26, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected statistics: %#v", stats)
+	}
+
+	// Too many days:
+	body, _ := json.Marshal(map[string]string{"query": `{ jobsStatistics(filter: [{cluster: {eq: "testcluster"}}], timeBucket: {size: DAY}) { id } }`})
+	req := httptest.NewRequest(http.MethodPost, "/query", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	srv.ServeHTTP(recorder, req)
+	if !strings.Contains(recorder.Body.String(), "too many time buckets") {
+		t.Errorf("expected an error, got: %s", recorder.Body.String())
+	}
+}
+
+func subtestArchivingQueue(t *testing.T, r *mux.Router) {
+	request := func(method, url string) (*http.Response, []*schema.ArchivingTask) {
+		req := httptest.NewRequest(method, url, nil)
+		recorder := httptest.NewRecorder()
+
+		r.ServeHTTP(recorder, req)
+		response := recorder.Result()
+		if response.StatusCode != http.StatusOK {
+			return response, nil
+		}
+
+		var tasks []*schema.ArchivingTask
+		if method == http.MethodGet {
+			if err := json.NewDecoder(recorder.Body).Decode(&tasks); err != nil {
+				t.Fatal(err)
+			}
+		} else {
+			task := &schema.ArchivingTask{}
+			if err := json.NewDecoder(recorder.Body).Decode(task); err != nil {
+				t.Fatal(err)
+			}
+			tasks = append(tasks, task)
+		}
+		return response, tasks
+	}
+
+	// An entry for a job that does not exist (anymore) that could not be archived:
+	queue := repository.GetArchivingRepository()
+	if _, err := queue.DB.Exec(`INSERT INTO archive_queue (job_id, state, attempts, last_error, next_attempt, created_at)
+		VALUES (999999, 'failed', 5, 'timeout', 0, 0)`); err != nil {
+		t.Fatal(err)
+	}
+
+	_, tasks := request(http.MethodGet, "/api/archiving/?state=failed")
+	if len(tasks) != 1 || tasks[0].JobID != 999999 || tasks[0].LastError != "timeout" {
+		t.Fatalf("unexpected queue: %#v", tasks)
+	}
+	id := tasks[0].ID
+
+	if response, _ := request(http.MethodGet, "/api/archiving/?state=foo"); response.StatusCode != http.StatusBadRequest {
+		t.Fatal(response.Status)
+	}
+	if response, _ := request(http.MethodPost, "/api/archiving/abandon/424242"); response.StatusCode != http.StatusNotFound {
+		t.Fatal(response.Status)
+	}
+
+	_, tasks = request(http.MethodPost, fmt.Sprintf("/api/archiving/abandon/%d", id))
+	if len(tasks) != 1 || tasks[0].State != schema.ArchivingStateAbandoned {
+		t.Fatalf("unexpected response: %#v", tasks)
+	}
+
+	// Retrying makes the workers process the entry, which is removed as the job does not exist.
+	_, tasks = request(http.MethodPost, fmt.Sprintf("/api/archiving/retry/%d", id))
+	if len(tasks) != 1 || tasks[0].Attempts != 0 {
+		t.Fatalf("unexpected response: %#v", tasks)
+	}
+	for i := 0; ; i++ {
+		if _, err := queue.FindTask(id); err == sql.ErrNoRows {
+			break
+		} else if i == 50 {
+			t.Fatalf("entry not processed: %v", err)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func subtestBackupRestore(t *testing.T, restapi *api.RestApi, r *mux.Router) {
+	req := httptest.NewRequest(http.MethodGet, "/api/db/backup/", nil)
+	recorder := httptest.NewRecorder()
+
+	r.ServeHTTP(recorder, req)
+	response := recorder.Result()
+	if response.StatusCode != http.StatusOK {
+		t.Fatal(response.Status, recorder.Body.String())
+	}
+
+	backup := filepath.Join(t.TempDir(), "backup.db")
+	if err := os.WriteFile(backup, recorder.Body.Bytes(), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	countBefore, err := restapi.JobRepository.CountJobs(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := restapi.JobRepository.DeleteJobsBefore(time.Now().Unix()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := repository.RestoreDB(backup); err != nil {
+		t.Fatal(err)
+	}
+
+	countAfter, err := restapi.JobRepository.CountJobs(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if countBefore == 0 || countBefore != countAfter {
+		t.Fatalf("expected %d jobs after restore, got %d", countBefore, countAfter)
+	}
+
+	node, err := repository.GetNodeRepository().FindNode("testcluster", "host123")
+	if err != nil || node.NodeState != schema.NodeStateDown {
+		t.Fatalf("node state not restored: %#v, %v", node, err)
+	}
+
+	// A backup with another table layout is rejected, nothing is changed:
+	other := filepath.Join(t.TempDir(), "other.db")
+	if err := os.WriteFile(other, recorder.Body.Bytes(), 0666); err != nil {
+		t.Fatal(err)
+	}
+	otherDB, err := sql.Open("sqlite3", other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := otherDB.Exec("ALTER TABLE node ADD COLUMN extra INTEGER"); err != nil {
+		t.Fatal(err)
+	}
+	otherDB.Close()
+	if err := repository.RestoreDB(other); err == nil || !strings.Contains(err.Error(), "does not match the database schema") {
+		t.Fatalf("expected the backup to be rejected, got: %v", err)
+	}
+	if count, err := restapi.JobRepository.CountJobs(context.Background(), nil); err != nil || count != countAfter {
+		t.Fatalf("expected %d jobs after a failed restore, got %d (%v)", countAfter, count, err)
+	}
+
+	// A backup from before the archiving queue existed: The queue is cleared anyway.
+	old, err := sql.Open("sqlite3", backup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := old.Exec("DROP TABLE archive_queue"); err != nil {
+		t.Fatal(err)
+	}
+	old.Close()
+
+	queue := repository.GetArchivingRepository()
+	if err := queue.Enqueue(999999, time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if err := repository.RestoreDB(backup); err != nil {
+		t.Fatal(err)
+	}
+	if tasks, err := queue.QueryTasks(nil); err != nil || len(tasks) != 0 {
+		t.Fatalf("expected an empty archiving queue after restore, got: %#v, %v", tasks, err)
+	}
+}
+
+func subtestArrayJob(t *testing.T, restapi *api.RestApi, r *mux.Router) {
+	const startArrayJobBody string = `{
+		"jobId":            %d,
+		"user":             "testuser",
+		"project":          "testproj",
+		"cluster":          "testcluster",
+		"partition":        "default",
+		"walltime":         3600,
+		"arrayJobId":       4242,
+		"numNodes":         1,
+		"numHwthreads":     8,
+		"numAcc":           0,
+		"exclusive":        1,
+		"monitoringStatus": 1,
+		"smt":              1,
+		"resources": [
+			{
+				"hostname": "host123",
+				"hwthreads": [0, 1, 2, 3, 4, 5, 6, 7]
+			}
+		],
+		"startTime": %d
+	}`
+
+	for i := 0; i < 3; i++ {
+		body := fmt.Sprintf(startArrayJobBody, 4243+i, 123456789+i)
+		req := httptest.NewRequest(http.MethodPost, "/api/jobs/start_job/", bytes.NewBuffer([]byte(body)))
+		recorder := httptest.NewRecorder()
+
+		r.ServeHTTP(recorder, req)
+		if response := recorder.Result(); response.StatusCode != http.StatusCreated {
+			t.Fatal(response.Status, recorder.Body.String())
+		}
+	}
+
+	arrayJob, err := restapi.Resolver.Query().ArrayJob(context.Background(), "testcluster", 4242)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if arrayJob == nil || arrayJob.NumJobs != 3 || arrayJob.StartTime.Unix() != 123456789 ||
+		len(arrayJob.States) != 1 || arrayJob.States[0].Name != string(schema.JobStateRunning) || arrayJob.States[0].Count != 3 {
+		t.Fatalf("unexpected array job: %#v", arrayJob)
+	}
+
+	members, err := restapi.Resolver.ArrayJob().Jobs(context.Background(), arrayJob, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(members) != 3 || members[0].JobID != 4243 || members[2].JobID != 4245 {
+		t.Fatalf("unexpected array job members: %#v", members)
+	}
+
+	groupByArray, cluster := true, "testcluster"
+	filter := []*model.JobFilter{{Cluster: &model.StringInput{Eq: &cluster}, GroupByArray: &groupByArray}}
+	jobs, err := restapi.JobRepository.QueryJobs(context.Background(), filter, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := restapi.JobRepository.CountJobs(context.Background(), filter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	arrayMembers := 0
+	for _, job := range jobs {
+		if job.ArrayJobId == 4242 {
+			arrayMembers += 1
+			if job.JobID != 4243 {
+				t.Fatalf("expected first member to represent the array job, got: %d", job.JobID)
+			}
+		}
+	}
+
+	if arrayMembers != 1 || count != len(jobs) {
+		t.Fatalf("unexpected grouped job list: %d array members, %d jobs, count %d", arrayMembers, len(jobs), count)
+	}
+}
+
+func subtestNodeState(t *testing.T, restapi *api.RestApi, r *mux.Router) {
+	updateNodeState := func(body string) *http.Response {
+		req := httptest.NewRequest(http.MethodPost, "/api/nodestate/", bytes.NewBuffer([]byte(body)))
+		recorder := httptest.NewRecorder()
+
+		r.ServeHTTP(recorder, req)
+		return recorder.Result()
+	}
+
+	for _, body := range []string{
+		`{"cluster": "testcluster", "timeStamp": 1000, "nodes": [{"hostname": "host123", "state": "idle"}]}`,
+		`{"cluster": "testcluster", "timeStamp": 2000, "nodes": [{"hostname": "host123", "state": "idle"}]}`,
+		`{"cluster": "testcluster", "timeStamp": 3000, "nodes": [{"hostname": "host123", "state": "down", "reason": "Not responding"}]}`,
+		`{"cluster": "testcluster", "timeStamp": 3000, "nodes": [{"hostname": "host124", "state": "idle"}, {"hostname": "host125", "state": "allocated"}]}`,
+	} {
+		if response := updateNodeState(body); response.StatusCode != http.StatusOK {
+			t.Fatal(response.Status)
+		}
+	}
+
+	if response := updateNodeState(`{"cluster": "testcluster", "nodes": [{"hostname": "host123", "state": "broken"}]}`); response.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected invalid state to be rejected, got %s", response.Status)
+	}
+
+	repo := repository.GetNodeRepository()
+	node, err := repo.FindNode("testcluster", "host123")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if node.SubCluster != "sc1" || node.NodeState != schema.NodeStateDown || node.Reason != "Not responding" || node.TimeStamp.Unix() != 3000 {
+		t.Fatalf("unexpected node properties: %#v", node)
+	}
+
+	history, err := repo.NodeStateHistory(node.ID, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(history) != 2 || history[0].NodeState != schema.NodeStateIdle || history[1].NodeState != schema.NodeStateDown {
+		t.Fatalf("unexpected state history: %#v", history)
+	}
+
+	counts, err := restapi.Resolver.Query().NodeStates(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(counts) != 3 || counts[0].Name != "allocated" || counts[1].Name != "down" || counts[2].Name != "idle" {
+		t.Fatalf("unexpected node state counts: %#v", counts)
+	}
+
+	// A late report is only added to the history:
+	if response := updateNodeState(`{"cluster": "testcluster", "timeStamp": 2500, "nodes": [{"hostname": "host123", "state": "drain"}]}`); response.StatusCode != http.StatusOK {
+		t.Fatal(response.Status)
+	}
+	if node, err := repo.FindNode("testcluster", "host123"); err != nil || node.NodeState != schema.NodeStateDown || node.TimeStamp.Unix() != 3000 {
+		t.Fatalf("unexpected node properties after a late report: %#v (%v)", node, err)
+	}
+	if history, err := repo.NodeStateHistory(node.ID, nil, nil); err != nil || len(history) != 3 || history[1].NodeState != schema.NodeStateDrain {
+		t.Fatalf("unexpected state history: %#v (%v)", history, err)
+	}
+
+	// host999 is not in the node list of any subcluster, nothing is updated:
+	if response := updateNodeState(`{"cluster": "testcluster", "timeStamp": 4000, "nodes": [{"hostname": "host124", "state": "down"}, {"hostname": "host999", "state": "idle"}]}`); response.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("expected the update to fail, got %s", response.Status)
+	}
+	if node, err := repo.FindNode("testcluster", "host124"); err != nil || node.NodeState != schema.NodeStateIdle {
+		t.Fatalf("expected no partial update: %#v (%v)", node, err)
+	}
 }
 
 func subtestLetJobFail(t *testing.T, restapi *api.RestApi, r *mux.Router) {